@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/randfill"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	t.Run("for DOCluster", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:         &infrav1.DOCluster{},
+		Spoke:       &DOCluster{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{DOClusterFuzzFuncs},
+	}))
+	t.Run("for DOMachine", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:         &infrav1.DOMachine{},
+		Spoke:       &DOMachine{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{DOMachineFuzzFuncs},
+	}))
+	t.Run("for DOMachineTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &infrav1.DOMachineTemplate{},
+		Spoke: &DOMachineTemplate{},
+	}))
+}
+
+func DOClusterFuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		hubDOClusterStatus,
+	}
+}
+
+func hubDOClusterStatus(in *infrav1.DOClusterStatus, c randfill.Continue) {
+	c.FillNoCustom(in)
+	// Drop empty structs with only omit empty fields.
+	if in.Initialization != nil && in.Initialization.Provisioned == nil {
+		in.Initialization = nil
+	}
+	// A zero time is serialized as null in the conversion data annotation.
+	if in.ControlPlaneDNSLastCheckTime != nil && in.ControlPlaneDNSLastCheckTime.IsZero() {
+		in.ControlPlaneDNSLastCheckTime = nil
+	}
+}
+
+func DOMachineFuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		hubDOMachineStatus,
+	}
+}
+
+func hubDOMachineStatus(in *infrav1.DOMachineStatus, c randfill.Continue) {
+	c.FillNoCustom(in)
+	// Drop empty structs with only omit empty fields.
+	if in.Initialization != nil && in.Initialization.Provisioned == nil {
+		in.Initialization = nil
+	}
+	// A zero time is serialized as null in the conversion data annotation.
+	if in.PowerOn != nil && in.PowerOn.LastAttemptTime != nil && in.PowerOn.LastAttemptTime.IsZero() {
+		in.PowerOn.LastAttemptTime = nil
+	}
+	if in.Deprecated != nil {
+		if in.Deprecated.V1Beta1 == nil || reflect.DeepEqual(in.Deprecated.V1Beta1, &infrav1.DOMachineV1Beta1DeprecatedStatus{}) {
+			in.Deprecated = nil
+		}
+	}
+}
@@ -17,4 +17,4 @@ limitations under the License.
 // Package v1alpha4 contains API Schema definitions for the infrastructure v1alpha4 API group
 package v1alpha4
 
-// +k8s:conversion-gen=sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1
+// +k8s:conversion-gen=sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2
@@ -17,16 +17,18 @@ limitations under the License.
 package v1alpha4
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/ptr"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 )
 
-// ConvertTo converts this DOCluster to the Hub version (v1beta1).
+// ConvertTo converts this DOCluster to the Hub version (v1beta2).
 func (src *DOCluster) ConvertTo(dstRaw conversion.Hub) error { // nolint
 	dst := dstRaw.(*infrav1.DOCluster)
-	if err := Convert_v1alpha4_DOCluster_To_v1beta1_DOCluster(src, dst, nil); err != nil {
+	if err := Convert_v1alpha4_DOCluster_To_v1beta2_DOCluster(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -36,13 +38,56 @@ func (src *DOCluster) ConvertTo(dstRaw conversion.Hub) error { // nolint
 		return err
 	}
 
+	dst.Spec.Network.VPC.Name = restored.Spec.Network.VPC.Name
+	dst.Spec.Network.VPC.IPRange = restored.Spec.Network.VPC.IPRange
+	dst.Spec.Network.VPC.Description = restored.Spec.Network.VPC.Description
+	dst.Status.Network.VPC = restored.Status.Network.VPC
+	dst.Spec.Network.Firewall = restored.Spec.Network.Firewall
+	dst.Status.Network.APIServerFirewallRef = restored.Status.Network.APIServerFirewallRef
+	dst.Status.Network.NodeFirewallRef = restored.Status.Network.NodeFirewallRef
+	dst.Status.Network.BastionFirewallRef = restored.Status.Network.BastionFirewallRef
+	dst.Spec.Bastion = restored.Spec.Bastion
+	dst.Spec.DNSProvider = restored.Spec.DNSProvider
+	dst.Spec.AdditionalDNSRecords = restored.Spec.AdditionalDNSRecords
+	dst.Status.DNSRecords = restored.Status.DNSRecords
+	dst.Spec.Network.APIServerEndpoint = restored.Spec.Network.APIServerEndpoint
+	dst.Spec.Network.APIServerLoadbalancers.Network = restored.Spec.Network.APIServerLoadbalancers.Network
+	dst.Spec.Network.APIServerPublicLoadbalancer = restored.Spec.Network.APIServerPublicLoadbalancer
+	dst.Status.Network.APIServerPublicLoadbalancerRef = restored.Status.Network.APIServerPublicLoadbalancerRef
+	dst.Status.Network.APIServerEndpoints = restored.Status.Network.APIServerEndpoints
+	dst.Status.Network.APIServerLoadbalancersRef.Ownership = restored.Status.Network.APIServerLoadbalancersRef.Ownership
+	dst.Spec.Network.APIServerLoadbalancers.SizeUnit = restored.Spec.Network.APIServerLoadbalancers.SizeUnit
+	dst.Spec.Network.APIServerLoadbalancers.HTTPIdleTimeoutSeconds = restored.Spec.Network.APIServerLoadbalancers.HTTPIdleTimeoutSeconds
+	dst.Spec.Network.APIServerLoadbalancers.EnableBackendKeepalive = restored.Spec.Network.APIServerLoadbalancers.EnableBackendKeepalive
+	dst.Spec.Network.APIServerLoadbalancers.EnableProxyProtocol = restored.Spec.Network.APIServerLoadbalancers.EnableProxyProtocol
+	dst.Spec.Network.APIServerLoadbalancers.Firewall = restored.Spec.Network.APIServerLoadbalancers.Firewall
+	dst.Spec.Network.APIServerLoadbalancers.AdditionalForwardingRules = restored.Spec.Network.APIServerLoadbalancers.AdditionalForwardingRules
+	dst.Status.Network.APIServerReservedIP = restored.Status.Network.APIServerReservedIP
+	dst.Spec.Network.IPFamily = restored.Spec.Network.IPFamily
+	dst.Status.Bastion = restored.Status.Bastion
+	dst.Status.ControlPlaneDNS = restored.Status.ControlPlaneDNS
+	dst.Status.ControlPlaneDNSLastCheckTime = restored.Status.ControlPlaneDNSLastCheckTime
+	dst.Status.Conditions = restored.Status.Conditions
+	dst.Spec.AdditionalTags = restored.Spec.AdditionalTags
+	dst.Spec.Project = restored.Spec.Project
+	dst.Spec.IdentityRef = restored.Spec.IdentityRef
+	dst.Status.ProjectID = restored.Status.ProjectID
+	dst.Status.AppliedTags = restored.Status.AppliedTags
+	// A false Ready does not tell an explicit provisioned false from an unset one.
+	if !src.Status.Ready && restored.Status.Initialization != nil && !ptr.Deref(restored.Status.Initialization.Provisioned, true) {
+		dst.Status.Initialization = restored.Status.Initialization
+	}
+	if dst.Spec.ControlPlaneDNS != nil && restored.Spec.ControlPlaneDNS != nil {
+		dst.Spec.ControlPlaneDNS.TTL = restored.Spec.ControlPlaneDNS.TTL
+	}
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this version.
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
 func (dst *DOCluster) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	src := srcRaw.(*infrav1.DOCluster)
-	if err := Convert_v1beta1_DOCluster_To_v1alpha4_DOCluster(src, dst, nil); err != nil {
+	if err := Convert_v1beta2_DOCluster_To_v1alpha4_DOCluster(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -54,14 +99,69 @@ func (dst *DOCluster) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	return nil
 }
 
-// ConvertTo converts this DOClusterList to the Hub version (v1beta1).
+// ConvertTo converts this DOClusterList to the Hub version (v1beta2).
 func (src *DOClusterList) ConvertTo(dstRaw conversion.Hub) error { // nolint
 	dst := dstRaw.(*infrav1.DOClusterList)
-	return Convert_v1alpha4_DOClusterList_To_v1beta1_DOClusterList(src, dst, nil)
+	return Convert_v1alpha4_DOClusterList_To_v1beta2_DOClusterList(src, dst, nil)
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this version.
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
 func (dst *DOClusterList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	src := srcRaw.(*infrav1.DOClusterList)
-	return Convert_v1beta1_DOClusterList_To_v1alpha4_DOClusterList(src, dst, nil)
+	return Convert_v1beta2_DOClusterList_To_v1alpha4_DOClusterList(src, dst, nil)
+}
+
+// Convert_v1beta2_DOClusterSpec_To_v1alpha4_DOClusterSpec converts from the Hub version (v1beta2) of the DOClusterSpec to this version.
+func Convert_v1beta2_DOClusterSpec_To_v1alpha4_DOClusterSpec(in *infrav1.DOClusterSpec, out *DOClusterSpec, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOClusterSpec_To_v1alpha4_DOClusterSpec(in, out, s)
+}
+
+// Convert_v1beta2_DOClusterStatus_To_v1alpha4_DOClusterStatus converts from the Hub version (v1beta2) of the DOClusterStatus to this version.
+func Convert_v1beta2_DOClusterStatus_To_v1alpha4_DOClusterStatus(in *infrav1.DOClusterStatus, out *DOClusterStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta2_DOClusterStatus_To_v1alpha4_DOClusterStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Ready = in.Initialization != nil && ptr.Deref(in.Initialization.Provisioned, false)
+	return nil
+}
+
+// Convert_v1alpha4_DOClusterStatus_To_v1beta2_DOClusterStatus converts this DOClusterStatus to the Hub version (v1beta2).
+func Convert_v1alpha4_DOClusterStatus_To_v1beta2_DOClusterStatus(in *DOClusterStatus, out *infrav1.DOClusterStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1alpha4_DOClusterStatus_To_v1beta2_DOClusterStatus(in, out, s); err != nil {
+		return err
+	}
+	if in.Ready {
+		out.Initialization = &infrav1.DOClusterInitializationStatus{Provisioned: ptr.To(true)}
+	}
+	return nil
+}
+
+// Convert_v1beta2_DONetwork_To_v1alpha4_DONetwork converts from the Hub version (v1beta2) of the DONetwork to this version.
+func Convert_v1beta2_DONetwork_To_v1alpha4_DONetwork(in *infrav1.DONetwork, out *DONetwork, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DONetwork_To_v1alpha4_DONetwork(in, out, s)
+}
+
+// Convert_v1beta2_DOLoadBalancer_To_v1alpha4_DOLoadBalancer converts from the Hub version (v1beta2) of the DOLoadBalancer to this version.
+func Convert_v1beta2_DOLoadBalancer_To_v1alpha4_DOLoadBalancer(in *infrav1.DOLoadBalancer, out *DOLoadBalancer, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOLoadBalancer_To_v1alpha4_DOLoadBalancer(in, out, s)
+}
+
+// Convert_v1beta2_DONetworkResource_To_v1alpha4_DONetworkResource converts from the Hub version (v1beta2) of the DONetworkResource to this version.
+func Convert_v1beta2_DONetworkResource_To_v1alpha4_DONetworkResource(in *infrav1.DONetworkResource, out *DONetworkResource, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DONetworkResource_To_v1alpha4_DONetworkResource(in, out, s)
+}
+
+// Convert_v1beta2_DOResourceReference_To_v1alpha4_DOResourceReference converts from the Hub version (v1beta2) of the DOResourceReference to this version.
+func Convert_v1beta2_DOResourceReference_To_v1alpha4_DOResourceReference(in *infrav1.DOResourceReference, out *DOResourceReference, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOResourceReference_To_v1alpha4_DOResourceReference(in, out, s)
+}
+
+// Convert_v1beta2_DOVPC_To_v1alpha4_DOVPC converts from the Hub version (v1beta2) of the DOVPC to this version.
+func Convert_v1beta2_DOVPC_To_v1alpha4_DOVPC(in *infrav1.DOVPC, out *DOVPC, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOVPC_To_v1alpha4_DOVPC(in, out, s)
+}
+
+// Convert_v1beta2_DOControlPlaneDNS_To_v1alpha4_DOControlPlaneDNS converts from the Hub version (v1beta2) of the DOControlPlaneDNS to this version.
+func Convert_v1beta2_DOControlPlaneDNS_To_v1alpha4_DOControlPlaneDNS(in *infrav1.DOControlPlaneDNS, out *DOControlPlaneDNS, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOControlPlaneDNS_To_v1alpha4_DOControlPlaneDNS(in, out, s)
 }
@@ -20,13 +20,13 @@ import (
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 )
 
-// ConvertTo converts this DOMachineTemplate to the Hub version (v1beta1).
+// ConvertTo converts this DOMachineTemplate to the Hub version (v1beta2).
 func (src *DOMachineTemplate) ConvertTo(dstRaw conversion.Hub) error { // nolint
 	dst := dstRaw.(*infrav1.DOMachineTemplate)
-	if err := Convert_v1alpha4_DOMachineTemplate_To_v1beta1_DOMachineTemplate(src, dst, nil); err != nil {
+	if err := Convert_v1alpha4_DOMachineTemplate_To_v1beta2_DOMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -36,13 +36,15 @@ func (src *DOMachineTemplate) ConvertTo(dstRaw conversion.Hub) error { // nolint
 		return err
 	}
 
+	dst.Spec.Template.Spec.IPFamily = restored.Spec.Template.Spec.IPFamily
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this version.
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
 func (dst *DOMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	src := srcRaw.(*infrav1.DOMachineTemplate)
-	if err := Convert_v1beta1_DOMachineTemplate_To_v1alpha4_DOMachineTemplate(src, dst, nil); err != nil {
+	if err := Convert_v1beta2_DOMachineTemplate_To_v1alpha4_DOMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -54,14 +56,14 @@ func (dst *DOMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error { // noli
 	return nil
 }
 
-// ConvertTo converts this DOMachineTemplateList to the Hub version (v1beta1).
+// ConvertTo converts this DOMachineTemplateList to the Hub version (v1beta2).
 func (src *DOMachineTemplateList) ConvertTo(dstRaw conversion.Hub) error { // nolint
 	dst := dstRaw.(*infrav1.DOMachineTemplateList)
-	return Convert_v1alpha4_DOMachineTemplateList_To_v1beta1_DOMachineTemplateList(src, dst, nil)
+	return Convert_v1alpha4_DOMachineTemplateList_To_v1beta2_DOMachineTemplateList(src, dst, nil)
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this version.
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
 func (dst *DOMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	src := srcRaw.(*infrav1.DOMachineTemplateList)
-	return Convert_v1beta1_DOMachineTemplateList_To_v1alpha4_DOMachineTemplateList(src, dst, nil)
+	return Convert_v1beta2_DOMachineTemplateList_To_v1alpha4_DOMachineTemplateList(src, dst, nil)
 }
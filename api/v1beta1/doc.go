@@ -15,3 +15,5 @@ limitations under the License.
 */
 
 package v1beta1
+
+// +k8s:conversion-gen=sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2
@@ -16,8 +16,97 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks DOCluster as a conversion hub.
-func (*DOCluster) Hub() {}
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/ptr"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks DOClusterList as a conversion hub.
-func (*DOClusterList) Hub() {}
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// ConvertTo converts this DOCluster to the Hub version (v1beta2).
+func (src *DOCluster) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOCluster)
+	if err := Convert_v1beta1_DOCluster_To_v1beta2_DOCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data from annotations
+	restored := &infrav1.DOCluster{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.AdditionalTags = restored.Spec.AdditionalTags
+	dst.Spec.Project = restored.Spec.Project
+	dst.Spec.IdentityRef = restored.Spec.IdentityRef
+	dst.Status.ProjectID = restored.Status.ProjectID
+	dst.Status.AppliedTags = restored.Status.AppliedTags
+	dst.Status.ControlPlaneDNSLastCheckTime = restored.Status.ControlPlaneDNSLastCheckTime
+	dst.Status.Network.VPC.Ownership = restored.Status.Network.VPC.Ownership
+
+	// A false Ready does not tell an explicit provisioned false from an unset one.
+	if !src.Status.Ready && restored.Status.Initialization != nil && !ptr.Deref(restored.Status.Initialization.Provisioned, true) {
+		dst.Status.Initialization = restored.Status.Initialization
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOCluster) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOCluster)
+	if err := Convert_v1beta2_DOCluster_To_v1beta1_DOCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this DOClusterList to the Hub version (v1beta2).
+func (src *DOClusterList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOClusterList)
+	return Convert_v1beta1_DOClusterList_To_v1beta2_DOClusterList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOClusterList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOClusterList)
+	return Convert_v1beta2_DOClusterList_To_v1beta1_DOClusterList(src, dst, nil)
+}
+
+// Convert_v1beta1_DOClusterStatus_To_v1beta2_DOClusterStatus converts this DOClusterStatus to the Hub version (v1beta2).
+func Convert_v1beta1_DOClusterStatus_To_v1beta2_DOClusterStatus(in *DOClusterStatus, out *infrav1.DOClusterStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta1_DOClusterStatus_To_v1beta2_DOClusterStatus(in, out, s); err != nil {
+		return err
+	}
+	if in.Ready {
+		out.Initialization = &infrav1.DOClusterInitializationStatus{Provisioned: ptr.To(true)}
+	}
+	return nil
+}
+
+// Convert_v1beta2_DOClusterStatus_To_v1beta1_DOClusterStatus converts from the Hub version (v1beta2) of the DOClusterStatus to this version.
+func Convert_v1beta2_DOClusterStatus_To_v1beta1_DOClusterStatus(in *infrav1.DOClusterStatus, out *DOClusterStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta2_DOClusterStatus_To_v1beta1_DOClusterStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Ready = in.Initialization != nil && ptr.Deref(in.Initialization.Provisioned, false)
+	return nil
+}
+
+// Convert_v1beta2_DOClusterSpec_To_v1beta1_DOClusterSpec converts from the Hub version (v1beta2) of the DOClusterSpec to this version.
+func Convert_v1beta2_DOClusterSpec_To_v1beta1_DOClusterSpec(in *infrav1.DOClusterSpec, out *DOClusterSpec, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOClusterSpec_To_v1beta1_DOClusterSpec(in, out, s)
+}
+
+// Convert_v1beta2_DOVPCResource_To_v1beta1_DOVPCResource converts from the Hub version (v1beta2) of the DOVPCResource to this version.
+func Convert_v1beta2_DOVPCResource_To_v1beta1_DOVPCResource(in *infrav1.DOVPCResource, out *DOVPCResource, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta2_DOVPCResource_To_v1beta1_DOVPCResource(in, out, s)
+}
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
@@ -45,6 +46,50 @@ type DOClusterSpec struct {
 	// IP used for the ControlPlaneEndpoint.
 	// +optional
 	ControlPlaneDNS *DOControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+	// DNSProvider configures the DNS provider managing the ControlPlaneDNS
+	// record. Defaults to the DigitalOcean Domains API.
+	// +optional
+	DNSProvider *DODNSProvider `json:"dnsProvider,omitempty"`
+	// AdditionalDNSRecords are extra DNS records managed for the cluster,
+	// e.g. a wildcard record for ingress or records for the control plane
+	// machines.
+	// +optional
+	AdditionalDNSRecords []DODNSRecord `json:"additionalDNSRecords,omitempty"`
+	// Bastion configures a bastion droplet in the cluster VPC that can be
+	// used to reach the cluster droplets over SSH.
+	// +optional
+	Bastion *DOBastion `json:"bastion,omitempty"`
+}
+
+// DOBastion defines the bastion droplet of the cluster.
+type DOBastion struct {
+	// Droplet size. It must be known DigitalOcean droplet size. See https://developers.digitalocean.com/documentation/v2/#list-all-sizes
+	Size string `json:"size"`
+	// Droplet image can be image id or slug. See https://developers.digitalocean.com/documentation/v2/#list-all-images
+	Image intstr.IntOrString `json:"image"`
+	// SSHKeys is the ssh key id or fingerprint to attach in DigitalOcean droplet.
+	// It must be available on DigitalOcean account. See https://developers.digitalocean.com/documentation/v2/#list-all-keys
+	SSHKeys []intstr.IntOrString `json:"sshKeys"`
+	// AllowedCIDRs is the list of CIDRs allowed to connect to the bastion over SSH.
+	// If omitted, SSH is allowed from everywhere.
+	// +optional
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+}
+
+// DOBastionStatus describes the bastion droplet of the cluster.
+type DOBastionStatus struct {
+	// ID of the bastion droplet.
+	// +optional
+	ResourceID string `json:"resourceId,omitempty"`
+	// Status of the bastion droplet.
+	// +optional
+	ResourceStatus DOResourceStatus `json:"resourceStatus,omitempty"`
+	// PublicIP is the public IPv4 address of the bastion droplet.
+	// +optional
+	PublicIP string `json:"publicIP,omitempty"`
+	// PrivateIP is the private IPv4 address of the bastion droplet.
+	// +optional
+	PrivateIP string `json:"privateIP,omitempty"`
 }
 
 // DOClusterStatus defines the observed state of DOCluster.
@@ -56,14 +101,31 @@ type DOClusterStatus struct {
 	// propagated to the DO DNS servers.
 	// +optional
 	ControlPlaneDNSRecordReady bool `json:"controlPlaneDNSRecordReady,omitempty"`
+	// ControlPlaneDNS is the DNS record currently managed for the control
+	// plane endpoint. It differs from the spec while the record is being moved
+	// and is used to remove the previous record once the new one is propagated.
+	// +optional
+	ControlPlaneDNS *DOControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+	// DNSRecords are the additional DNS records currently managed for the
+	// cluster.
+	// +optional
+	DNSRecords []DODNSRecordStatus `json:"dnsRecords,omitempty"`
 	// Network encapsulates all things related to DigitalOcean network.
 	// +optional
 	Network DONetworkResource `json:"network,omitempty"`
+	// Bastion describes the bastion droplet of the cluster.
+	// +optional
+	Bastion *DOBastionStatus `json:"bastion,omitempty"`
+	// Conditions defines current service state of the DOCluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=doclusters,scope=Namespaced,categories=cluster-api
-// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this DOCluster belongs"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Cluster infrastructure is ready for DigitalOcean droplet instances"
@@ -78,6 +140,16 @@ type DOCluster struct {
 	Status DOClusterStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the set of conditions for this object.
+func (c *DOCluster) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions on this object.
+func (c *DOCluster) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 
 // DOClusterList contains a list of DOCluster.
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// ConvertTo converts this DOClusterTemplate to the Hub version (v1beta2).
+func (src *DOClusterTemplate) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOClusterTemplate)
+	if err := Convert_v1beta1_DOClusterTemplate_To_v1beta2_DOClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data from annotations
+	restored := &infrav1.DOClusterTemplate{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.Template.Spec.AdditionalTags = restored.Spec.Template.Spec.AdditionalTags
+	dst.Spec.Template.Spec.Project = restored.Spec.Template.Spec.Project
+	dst.Spec.Template.Spec.IdentityRef = restored.Spec.Template.Spec.IdentityRef
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOClusterTemplate)
+	if err := Convert_v1beta2_DOClusterTemplate_To_v1beta1_DOClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	return utilconversion.MarshalData(src, dst)
+}
+
+// ConvertTo converts this DOClusterTemplateList to the Hub version (v1beta2).
+func (src *DOClusterTemplateList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOClusterTemplateList)
+	return Convert_v1beta1_DOClusterTemplateList_To_v1beta2_DOClusterTemplateList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOClusterTemplateList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOClusterTemplateList)
+	return Convert_v1beta2_DOClusterTemplateList_To_v1beta1_DOClusterTemplateList(src, dst, nil)
+}
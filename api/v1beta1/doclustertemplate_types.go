@@ -32,7 +32,6 @@ type DOClusterTemplateResource struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=doclustertemplates,scope=Namespaced,categories=cluster-api,shortName=doct
-// +kubebuilder:storageversion
 
 // DOClusterTemplate is the Schema for the DOclustertemplates API.
 type DOClusterTemplate struct {
@@ -16,8 +16,94 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks DOMachine as a conversion hub.
-func (*DOMachine) Hub() {}
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/ptr"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks DOMachineList as a conversion hub.
-func (*DOMachineList) Hub() {}
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// ConvertTo converts this DOMachine to the Hub version (v1beta2).
+func (src *DOMachine) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOMachine)
+	if err := Convert_v1beta1_DOMachine_To_v1beta2_DOMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data from annotations
+	restored := &infrav1.DOMachine{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Status.PowerOn = restored.Status.PowerOn
+	dst.Status.AppliedTags = restored.Status.AppliedTags
+
+	// A false Ready does not tell an explicit provisioned false from an unset one.
+	if !src.Status.Ready && restored.Status.Initialization != nil && !ptr.Deref(restored.Status.Initialization.Provisioned, true) {
+		dst.Status.Initialization = restored.Status.Initialization
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOMachine) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOMachine)
+	if err := Convert_v1beta2_DOMachine_To_v1beta1_DOMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this DOMachineList to the Hub version (v1beta2).
+func (src *DOMachineList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOMachineList)
+	return Convert_v1beta1_DOMachineList_To_v1beta2_DOMachineList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOMachineList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOMachineList)
+	return Convert_v1beta2_DOMachineList_To_v1beta1_DOMachineList(src, dst, nil)
+}
+
+// Convert_v1beta1_DOMachineStatus_To_v1beta2_DOMachineStatus converts this DOMachineStatus to the Hub version (v1beta2).
+func Convert_v1beta1_DOMachineStatus_To_v1beta2_DOMachineStatus(in *DOMachineStatus, out *infrav1.DOMachineStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta1_DOMachineStatus_To_v1beta2_DOMachineStatus(in, out, s); err != nil {
+		return err
+	}
+	if in.Ready {
+		out.Initialization = &infrav1.DOMachineInitializationStatus{Provisioned: ptr.To(true)}
+	}
+	if in.FailureReason != nil || in.FailureMessage != nil {
+		out.Deprecated = &infrav1.DOMachineDeprecatedStatus{
+			V1Beta1: &infrav1.DOMachineV1Beta1DeprecatedStatus{
+				FailureReason:  in.FailureReason,
+				FailureMessage: in.FailureMessage,
+			},
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta2_DOMachineStatus_To_v1beta1_DOMachineStatus converts from the Hub version (v1beta2) of the DOMachineStatus to this version.
+func Convert_v1beta2_DOMachineStatus_To_v1beta1_DOMachineStatus(in *infrav1.DOMachineStatus, out *DOMachineStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta2_DOMachineStatus_To_v1beta1_DOMachineStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Ready = in.Initialization != nil && ptr.Deref(in.Initialization.Provisioned, false)
+	if in.Deprecated != nil && in.Deprecated.V1Beta1 != nil {
+		out.FailureReason = in.Deprecated.V1Beta1.FailureReason
+		out.FailureMessage = in.Deprecated.V1Beta1.FailureMessage
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+	capierrors "sigs.k8s.io/cluster-api/errors" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+func TestDOMachineConversion(t *testing.T) {
+	tests := []struct {
+		name   string
+		status infrav1.DOMachineStatus
+		want   DOMachineStatus
+	}{
+		{
+			name:   "provisioned",
+			status: infrav1.DOMachineStatus{Initialization: &infrav1.DOMachineInitializationStatus{Provisioned: ptr.To(true)}},
+			want:   DOMachineStatus{Ready: true},
+		},
+		{
+			name:   "explicitly not provisioned",
+			status: infrav1.DOMachineStatus{Initialization: &infrav1.DOMachineInitializationStatus{Provisioned: ptr.To(false)}},
+			want:   DOMachineStatus{},
+		},
+		{
+			name: "failed",
+			status: infrav1.DOMachineStatus{
+				Deprecated: &infrav1.DOMachineDeprecatedStatus{
+					V1Beta1: &infrav1.DOMachineV1Beta1DeprecatedStatus{
+						FailureReason:  ptr.To(capierrors.CreateMachineError),
+						FailureMessage: ptr.To("droplet is archived"),
+					},
+				},
+			},
+			want: DOMachineStatus{
+				FailureReason:  ptr.To(capierrors.CreateMachineError),
+				FailureMessage: ptr.To("droplet is archived"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &infrav1.DOMachine{Status: tt.status}
+
+			spoke := &DOMachine{}
+			if err := spoke.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom() error = %v", err)
+			}
+			if !reflect.DeepEqual(spoke.Status, tt.want) {
+				t.Errorf("ConvertFrom() status = %+v, want %+v", spoke.Status, tt.want)
+			}
+
+			restored := &infrav1.DOMachine{}
+			if err := spoke.ConvertTo(restored); err != nil {
+				t.Fatalf("ConvertTo() error = %v", err)
+			}
+			if !reflect.DeepEqual(restored.Status, hub.Status) {
+				t.Errorf("ConvertTo() status = %+v, want %+v", restored.Status, hub.Status)
+			}
+		})
+	}
+}
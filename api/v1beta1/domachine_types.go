@@ -46,6 +46,11 @@ type DOMachineSpec struct {
 	// AdditionalTags is an optional set of tags to add to DigitalOcean resources managed by the DigitalOcean provider.
 	// +optional
 	AdditionalTags Tags `json:"additionalTags,omitempty"`
+	// IPFamily of the droplet. It must be either "IPv4" or "DualStack".
+	// Defaults to the DOCluster network ipFamily.
+	// +optional
+	// +kubebuilder:validation:Enum=IPv4;DualStack
+	IPFamily DOIPFamily `json:"ipFamily,omitempty"`
 }
 
 // DOMachineStatus defines the observed state of DOMachine.
@@ -102,11 +107,17 @@ type DOMachineStatus struct {
 	// controller's output.
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the DOMachine.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=domachines,scope=Namespaced,categories=cluster-api
-// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this DOMachine belongs"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.instanceStatus",description="DigitalOcean droplet instance state"
@@ -123,6 +134,16 @@ type DOMachine struct {
 	Status DOMachineStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the set of conditions for this object.
+func (m *DOMachine) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+// SetConditions sets the conditions on this object.
+func (m *DOMachine) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 
 // DOMachineList contains a list of DOMachine.
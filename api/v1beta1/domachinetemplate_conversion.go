@@ -16,8 +16,32 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks DOMachineTemplate as a conversion hub.
-func (*DOMachineTemplate) Hub() {}
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks DOMachineTemplateList as a conversion hub.
-func (*DOMachineTemplateList) Hub() {}
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// ConvertTo converts this DOMachineTemplate to the Hub version (v1beta2).
+func (src *DOMachineTemplate) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOMachineTemplate)
+	return Convert_v1beta1_DOMachineTemplate_To_v1beta2_DOMachineTemplate(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOMachineTemplate)
+	return Convert_v1beta2_DOMachineTemplate_To_v1beta1_DOMachineTemplate(src, dst, nil)
+}
+
+// ConvertTo converts this DOMachineTemplateList to the Hub version (v1beta2).
+func (src *DOMachineTemplateList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.DOMachineTemplateList)
+	return Convert_v1beta1_DOMachineTemplateList_To_v1beta2_DOMachineTemplateList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this version.
+func (dst *DOMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.DOMachineTemplateList)
+	return Convert_v1beta2_DOMachineTemplateList_To_v1beta1_DOMachineTemplateList(src, dst, nil)
+}
@@ -31,6 +31,8 @@ const (
 	APIServerRoleTagValue = "apiserver"
 	// NodeRoleTagValue describes the value for the node role.
 	NodeRoleTagValue = "node"
+	// BastionRoleTagValue describes the value for the bastion role.
+	BastionRoleTagValue = "bastion"
 )
 
 // ClusterNameTag generates the tag with prefix `NameDigitalOceanProviderPrefix`
@@ -19,6 +19,8 @@ package v1beta1
 import (
 	"fmt"
 	"strings"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 // DOSafeName returns DigitalOcean safe name with replacing '.' and '/' to '-'
@@ -38,6 +40,143 @@ type DOControlPlaneDNS struct {
 	// The format must consist of alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character
 	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$
 	Name string `json:"name"`
+	// TTL is the time to live of the record in seconds. Defaults to 30.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	TTL int32 `json:"ttl,omitempty"`
+}
+
+// DefaultControlPlaneDNSTTL is the default TTL of the control plane DNS record.
+const DefaultControlPlaneDNSTTL int32 = 30
+
+// RecordTTL returns the TTL of the record, defaulting to DefaultControlPlaneDNSTTL.
+func (d *DOControlPlaneDNS) RecordTTL() int32 {
+	if d.TTL == 0 {
+		return DefaultControlPlaneDNSTTL
+	}
+	return d.TTL
+}
+
+// DODNSRecordTargetType is the type of target of an additional DNS record.
+type DODNSRecordTargetType string
+
+const (
+	// DODNSRecordTargetAPIServer points the record to the IP of the control plane endpoint.
+	DODNSRecordTargetAPIServer DODNSRecordTargetType = "APIServer"
+	// DODNSRecordTargetControlPlaneMachines creates one record per control plane
+	// machine, pointing to the machine address.
+	DODNSRecordTargetControlPlaneMachines DODNSRecordTargetType = "ControlPlaneMachines"
+	// DODNSRecordTargetValue points the record to a literal value.
+	DODNSRecordTargetValue DODNSRecordTargetType = "Value"
+)
+
+// DODNSRecord is an additional DNS record managed for the cluster.
+type DODNSRecord struct {
+	// Domain is the domain this record should live in. Defaults to the
+	// ControlPlaneDNS domain.
+	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	// +optional
+	Domain string `json:"domain,omitempty"`
+	// Name is the DNS short name of the record (non-FQDN), e.g. *.apps. For
+	// ControlPlaneMachines targets, it is used as a prefix and the records are
+	// named <name>-<n> with n the index of the machine sorted by name,
+	// counting only the machines which have an address of the record type.
+	// +kubebuilder:validation:Pattern:=^(\*\.)?[a-z0-9]([-a-z0-9.]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Type is the DNS record type. Defaults to A. AAAA records pointing to the
+	// API server or the control plane machines require a DualStack cluster.
+	// +kubebuilder:validation:Enum=A;AAAA;CNAME
+	// +optional
+	Type string `json:"type,omitempty"`
+	// TTL is the time to live of the record in seconds. Defaults to 30.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	TTL int32 `json:"ttl,omitempty"`
+	// Target is what the record points to.
+	Target DODNSRecordTarget `json:"target"`
+}
+
+// DODNSRecordTarget describes what an additional DNS record points to.
+type DODNSRecordTarget struct {
+	// Type is the type of target.
+	// +kubebuilder:validation:Enum=APIServer;ControlPlaneMachines;Value
+	Type DODNSRecordTargetType `json:"type"`
+	// Value is the literal data of the record for Value targets, an IPv4
+	// address for A records, an IPv6 address for AAAA records or a host name
+	// for CNAME records.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// AddressType is the type of the machine address used for
+	// ControlPlaneMachines targets. Defaults to ExternalIP. Droplets only have
+	// a public IPv6 address, so AAAA records must use ExternalIP.
+	// +kubebuilder:validation:Enum=ExternalIP;InternalIP
+	// +optional
+	AddressType corev1.NodeAddressType `json:"addressType,omitempty"`
+}
+
+// RecordType returns the DNS record type, defaulting to A.
+func (r *DODNSRecord) RecordType() string {
+	if r.Type == "" {
+		return "A"
+	}
+	return r.Type
+}
+
+// RecordTTL returns the TTL of the record, defaulting to DefaultControlPlaneDNSTTL.
+func (r *DODNSRecord) RecordTTL() int32 {
+	if r.TTL == 0 {
+		return DefaultControlPlaneDNSTTL
+	}
+	return r.TTL
+}
+
+// DODNSRecordStatus describes a DNS record managed for the cluster.
+type DODNSRecordStatus struct {
+	// Domain is the domain the record lives in.
+	Domain string `json:"domain"`
+	// Name is the DNS short name of the record.
+	Name string `json:"name"`
+	// Type is the DNS record type.
+	Type string `json:"type"`
+	// Data is the data of the record.
+	Data string `json:"data"`
+}
+
+// DODNSProviderType is the type of DNS provider managing the cluster DNS records.
+type DODNSProviderType string
+
+const (
+	// DODNSProviderDigitalOcean manages DNS records through the DigitalOcean Domains API.
+	DODNSProviderDigitalOcean DODNSProviderType = "digitalocean"
+	// DODNSProviderRFC2136 manages DNS records through RFC2136 dynamic updates.
+	DODNSProviderRFC2136 DODNSProviderType = "rfc2136"
+)
+
+// DODNSProvider configures the DNS provider managing the cluster DNS records.
+type DODNSProvider struct {
+	// Type is the DNS provider type. Defaults to digitalocean.
+	// +kubebuilder:validation:Enum=digitalocean;rfc2136
+	// +optional
+	Type DODNSProviderType `json:"type,omitempty"`
+	// RFC2136 configures the rfc2136 DNS provider. Required if type is rfc2136.
+	// +optional
+	RFC2136 *DORFC2136Provider `json:"rfc2136,omitempty"`
+}
+
+// DORFC2136Provider configures a DNS server accepting RFC2136 dynamic updates.
+// The record domain is used as the zone to update.
+type DORFC2136Provider struct {
+	// Server is the address of the authoritative DNS server, as host or host:port.
+	// The port defaults to 53.
+	Server string `json:"server"`
+	// CredentialsSecretRef references a Secret in the DOCluster namespace holding
+	// the TSIG key used to sign updates under the tsigKeyName, tsigSecret and
+	// optional tsigAlgorithm (defaults to hmac-sha256) keys. Updates are not
+	// signed if unset.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 }
 
 // DOResourceStatus describes the status of a DigitalOcean resource.
@@ -64,13 +203,70 @@ type DOResourceReference struct {
 	// Status of DigitalOcean resource
 	// +optional
 	ResourceStatus DOResourceStatus `json:"resourceStatus,omitempty"`
+	// Ownership records whether the resource was created for the cluster and is
+	// deleted along with it, or was adopted and must never be deleted.
+	// +optional
+	Ownership DOResourceOwnership `json:"ownership,omitempty"`
 }
 
+// DOResourceOwnership describes whether a DigitalOcean resource is managed by the provider.
+type DOResourceOwnership string
+
+const (
+	// DOResourceOwned is a resource created by the provider and deleted along with the cluster.
+	DOResourceOwned = DOResourceOwnership("owned")
+	// DOResourceUnmanaged is a pre-existing resource adopted by the cluster, which is never
+	// modified beyond tagging and never deleted by the provider.
+	DOResourceUnmanaged = DOResourceOwnership("unmanaged")
+)
+
 // DONetworkResource encapsulates DigitalOcean networking resources.
 type DONetworkResource struct {
 	// APIServerLoadbalancersRef is the id of apiserver loadbalancers.
 	// +optional
 	APIServerLoadbalancersRef DOResourceReference `json:"apiServerLoadbalancersRef,omitempty"`
+	// VPC describes the VPC created and managed for the cluster.
+	// +optional
+	VPC DOVPCResource `json:"vpc,omitempty"`
+	// APIServerFirewallRef is the id of the firewall protecting the control plane droplets.
+	// +optional
+	APIServerFirewallRef DOResourceReference `json:"apiServerFirewallRef,omitempty"`
+	// NodeFirewallRef is the id of the firewall protecting the worker droplets.
+	// +optional
+	NodeFirewallRef DOResourceReference `json:"nodeFirewallRef,omitempty"`
+	// BastionFirewallRef is the id of the firewall protecting the bastion droplet.
+	// +optional
+	BastionFirewallRef DOResourceReference `json:"bastionFirewallRef,omitempty"`
+	// APIServerReservedIP describes the reserved IP used as control plane
+	// endpoint when the API server endpoint type is ReservedIP.
+	// +optional
+	APIServerReservedIP DOReservedIPResource `json:"apiServerReservedIP,omitempty"`
+	// APIServerPublicLoadbalancerRef is the id of the public apiserver loadbalancer.
+	// +optional
+	APIServerPublicLoadbalancerRef DOResourceReference `json:"apiServerPublicLoadbalancerRef,omitempty"`
+	// APIServerEndpoints lists the endpoints the API server is reachable at.
+	// +optional
+	APIServerEndpoints []DOAPIServerEndpointStatus `json:"apiServerEndpoints,omitempty"`
+}
+
+// DOReservedIPResource describes a reserved IP managed by the DigitalOcean provider.
+type DOReservedIPResource struct {
+	// IP is the reserved IP address.
+	// +optional
+	IP string `json:"ip,omitempty"`
+	// DropletID is the ID of the droplet the reserved IP is currently assigned to.
+	// +optional
+	DropletID string `json:"dropletId,omitempty"`
+}
+
+// DOVPCResource describes a VPC managed by the DigitalOcean provider.
+type DOVPCResource struct {
+	// ID of the DigitalOcean VPC.
+	// +optional
+	ResourceID string `json:"resourceId,omitempty"`
+	// IPRange is the range of IP addresses of the VPC in CIDR notation.
+	// +optional
+	IPRange string `json:"ipRange,omitempty"`
 }
 
 // DOMachineTemplateResource describes the data needed to create am DOMachine from a template.
@@ -106,9 +302,125 @@ type DONetwork struct {
 	// Configures an API Server loadbalancers
 	// +optional
 	APIServerLoadbalancers DOLoadBalancer `json:"apiServerLoadbalancers,omitempty"`
+	// APIServerPublicLoadbalancer configures a second, public, API server load balancer
+	// next to an internal apiServerLoadbalancers. Its network must be EXTERNAL.
+	// +optional
+	APIServerPublicLoadbalancer *DOLoadBalancer `json:"apiServerPublicLoadbalancer,omitempty"`
+	// APIServerEndpoint configures how the API server is exposed.
+	// +optional
+	APIServerEndpoint DOAPIServerEndpoint `json:"apiServerEndpoint,omitempty"`
 	// VPC defines the VPC configuration.
 	// +optional
 	VPC DOVPC `json:"vpc,omitempty"`
+	// Firewall configures the DigitalOcean Cloud Firewalls protecting the
+	// cluster droplets. If omitted, no firewalls are managed.
+	// +optional
+	Firewall *DOFirewall `json:"firewall,omitempty"`
+	// IPFamily of the cluster. It must be either "IPv4" or "DualStack". With
+	// "DualStack", droplets get a public IPv6 address, external API server load
+	// balancers are dual-stack and AAAA records are managed next to the A
+	// records. The default value is "IPv4".
+	// +optional
+	// +kubebuilder:validation:Enum=IPv4;DualStack
+	IPFamily DOIPFamily `json:"ipFamily,omitempty"`
+}
+
+// DOIPFamily is the IP family of the cluster resources.
+type DOIPFamily string
+
+const (
+	// DOIPFamilyIPv4 only enables IPv4.
+	DOIPFamilyIPv4 = DOIPFamily("IPv4")
+	// DOIPFamilyDualStack enables both IPv4 and IPv6.
+	DOIPFamilyDualStack = DOIPFamily("DualStack")
+)
+
+// DOAPIServerEndpointType is the type of the control plane endpoint.
+type DOAPIServerEndpointType string
+
+const (
+	// DOAPIServerEndpointTypeLoadBalancer exposes the API server through a DigitalOcean load balancer.
+	DOAPIServerEndpointTypeLoadBalancer = DOAPIServerEndpointType("LoadBalancer")
+	// DOAPIServerEndpointTypeReservedIP exposes the API server through a reserved IP
+	// assigned to a healthy control plane droplet.
+	DOAPIServerEndpointTypeReservedIP = DOAPIServerEndpointType("ReservedIP")
+)
+
+// DOAPIServerEndpoint define how the API server is exposed.
+type DOAPIServerEndpoint struct {
+	// Type of the control plane endpoint. It must be either "LoadBalancer" or
+	// "ReservedIP". With "ReservedIP", no load balancer is created; instead a
+	// reserved IP is allocated and reassigned to a healthy control plane droplet
+	// whenever its current holder is deleted or unhealthy. The API server port
+	// is then taken from apiServerLoadbalancers.port. The default value is "LoadBalancer".
+	// +optional
+	// +kubebuilder:validation:Enum=LoadBalancer;ReservedIP
+	Type DOAPIServerEndpointType `json:"type,omitempty"`
+	// Primary selects the endpoint used as control plane endpoint, and targeted by the
+	// control plane DNS record, when both a private and a public load balancer are created.
+	// It must be either "Private" or "Public". The default value is "Private".
+	// +optional
+	// +kubebuilder:validation:Enum=Private;Public
+	Primary DOEndpointVisibility `json:"primary,omitempty"`
+}
+
+// DOEndpointVisibility defines from where an API server endpoint is reachable.
+type DOEndpointVisibility string
+
+const (
+	// DOEndpointVisibilityPrivate is an endpoint only reachable from inside the VPC.
+	DOEndpointVisibilityPrivate = DOEndpointVisibility("Private")
+	// DOEndpointVisibilityPublic is an endpoint reachable from the internet.
+	DOEndpointVisibilityPublic = DOEndpointVisibility("Public")
+)
+
+// DOAPIServerEndpointStatus describes an endpoint the API server is reachable at.
+type DOAPIServerEndpointStatus struct {
+	// Visibility defines from where the endpoint is reachable.
+	Visibility DOEndpointVisibility `json:"visibility"`
+	// Host is the IP address of the endpoint.
+	Host string `json:"host"`
+	// HostIPv6 is the IPv6 address of the endpoint, if it is dual-stack.
+	// +optional
+	HostIPv6 string `json:"hostIPv6,omitempty"`
+	// Port is the port of the endpoint.
+	Port int32 `json:"port"`
+	// Primary is true if the endpoint is the control plane endpoint.
+	// +optional
+	Primary bool `json:"primary,omitempty"`
+}
+
+// DOFirewall define the DigitalOcean Cloud Firewalls configuration.
+// A firewall is created per role (apiserver and node) and applied to the droplets
+// through their role tag. By default, the API server is only reachable from the
+// API server load balancer and from inside the VPC, the kubelet and etcd only from
+// inside the VPC, and all traffic between the cluster droplets is allowed.
+type DOFirewall struct {
+	// AdditionalInboundRules is an optional list of inbound rules to add to the
+	// generated firewalls, e.g. to allow SSH from a trusted network.
+	// +optional
+	AdditionalInboundRules []DOFirewallInboundRule `json:"additionalInboundRules,omitempty"`
+}
+
+// DOFirewallInboundRule define an inbound rule of a DigitalOcean Cloud Firewall.
+type DOFirewallInboundRule struct {
+	// Protocol of the traffic. It must be either "tcp", "udp" or "icmp".
+	// +kubebuilder:validation:Enum=tcp;udp;icmp
+	Protocol string `json:"protocol"`
+	// Ports on which the traffic is allowed. It can be a single port, a range
+	// (e.g. "8000-9000") or "all". It is ignored for the icmp protocol.
+	// If omitted, all ports are allowed.
+	// +optional
+	Ports string `json:"ports,omitempty"`
+	// Sources is the list of IPv4 or IPv6 addresses or CIDRs the traffic is
+	// allowed from.
+	// +kubebuilder:validation:MinItems=1
+	Sources []string `json:"sources"`
+	// Role restricts the rule to the firewall of the droplets with the given role.
+	// It must be either "apiserver" or "node". If omitted, the rule is added to both.
+	// +optional
+	// +kubebuilder:validation:Enum=apiserver;node
+	Role string `json:"role,omitempty"`
 }
 
 // DOLoadBalancer define the DigitalOcean loadbalancers configurations.
@@ -127,16 +439,110 @@ type DOLoadBalancer struct {
 	// +optional
 	HealthCheck DOLoadBalancerHealthCheck `json:"healthCheck,omitempty"`
 	// The DO load balancer UUID. If omitted, a new load balancer will be created.
+	// An existing load balancer is adopted: it must be in the cluster region and VPC,
+	// it is tagged with the cluster role tag, its settings are left untouched and it
+	// is never deleted.
 	// +optional
 	ResourceID string `json:"resourceId,omitempty"`
+	// Network defines whether the load balancer is reachable from the internet or only
+	// from inside the VPC. It must be either "EXTERNAL" or "INTERNAL". The default value is "EXTERNAL".
+	// +optional
+	// +kubebuilder:validation:Enum=EXTERNAL;INTERNAL
+	Network string `json:"network,omitempty"`
+	// SizeUnit is the number of nodes of the load balancer. It must be between 1 and 100.
+	// If omitted, DigitalOcean creates a single node load balancer.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SizeUnit int32 `json:"sizeUnit,omitempty"`
+	// HTTPIdleTimeoutSeconds is the number of seconds an idle HTTP connection is kept open.
+	// It must be between 30 and 600 and requires an http forwarding rule.
+	// If omitted, DigitalOcean uses 60 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=600
+	HTTPIdleTimeoutSeconds int32 `json:"httpIdleTimeoutSeconds,omitempty"`
+	// EnableBackendKeepalive keeps the connections between the load balancer and the droplets open.
+	// +optional
+	EnableBackendKeepalive bool `json:"enableBackendKeepalive,omitempty"`
+	// EnableProxyProtocol sends the PROXY protocol header to the droplets on every forwarding rule,
+	// including the API server one. kube-apiserver does not understand the PROXY protocol, so it
+	// must only be enabled when a proxy supporting it terminates the connections on the droplets.
+	// +optional
+	EnableProxyProtocol bool `json:"enableProxyProtocol,omitempty"`
+	// Firewall restricts the sources allowed to reach the load balancer.
+	// +optional
+	Firewall *DOLoadBalancerFirewall `json:"firewall,omitempty"`
+	// AdditionalForwardingRules is an optional list of forwarding rules to add next to the
+	// API server one, e.g. for konnectivity or a second API server port.
+	// +optional
+	AdditionalForwardingRules []DOForwardingRule `json:"additionalForwardingRules,omitempty"`
+}
+
+// DOLoadBalancerFirewall define the sources allowed or denied to reach the load balancer.
+type DOLoadBalancerFirewall struct {
+	// Allow is the list of IP addresses or CIDRs allowed to reach the load balancer.
+	// If set, all other sources are denied.
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+	// Deny is the list of IP addresses or CIDRs denied to reach the load balancer.
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+}
+
+// DOForwardingRule define a forwarding rule of the load balancer.
+type DOForwardingRule struct {
+	// Protocol of the traffic. It must be either "tcp", "udp" or "http".
+	// +kubebuilder:validation:Enum=tcp;udp;http
+	Protocol string `json:"protocol"`
+	// EntryPort is the port the load balancer listens on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EntryPort int32 `json:"entryPort"`
+	// TargetPort is the port of the droplets the traffic is forwarded to.
+	// If omitted, the entry port is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	TargetPort int32 `json:"targetPort,omitempty"`
+}
+
+// Target returns the port of the droplets the traffic is forwarded to.
+func (in *DOForwardingRule) Target() int32 {
+	if in.TargetPort != 0 {
+		return in.TargetPort
+	}
+	return in.EntryPort
 }
 
 // DOVPC define the DigitalOcean VPC configuration.
 type DOVPC struct {
 	// VPCUUID defines the VPC UUID to use. An empty value implies using the
-	// default VPC.
+	// default VPC, unless Name or IPRange describe a VPC to be created.
 	// +optional
 	VPCUUID string `json:"vpc_uuid,omitempty"`
+	// Name of the VPC to create for the cluster. If omitted and IPRange is
+	// set, the name is generated from the cluster name and UID.
+	// It must be unique within the account and may only contain
+	// alphanumeric characters and dashes.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern:=^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$
+	Name string `json:"name,omitempty"`
+	// IPRange is the range of IP addresses of the VPC to create, in CIDR
+	// notation. If omitted, DigitalOcean picks a free range.
+	// +optional
+	IPRange string `json:"ipRange,omitempty"`
+	// Description of the VPC to create.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+}
+
+// IsManaged returns true if the VPC is created and deleted along with the
+// cluster rather than referenced by VPCUUID.
+func (in *DOVPC) IsManaged() bool {
+	return in.VPCUUID == "" && (in.Name != "" || in.IPRange != "")
 }
 
 // DOVolume defines a DO Block Storage Volume.
@@ -144,6 +550,13 @@ type DOVolume struct {
 	ID string `json:"id"`
 }
 
+const (
+	// LBNetworkExternal is the network of a load balancer reachable from the internet.
+	LBNetworkExternal = "EXTERNAL"
+	// LBNetworkInternal is the network of a load balancer only reachable from inside the VPC.
+	LBNetworkInternal = "INTERNAL"
+)
+
 var (
 	// DefaultLBPort default LoadBalancer port.
 	DefaultLBPort int32 = 6443
@@ -167,6 +580,9 @@ func (in *DOLoadBalancer) ApplyDefault() {
 	if in.Algorithm == "" {
 		in.Algorithm = DefaultLBAlgorithm
 	}
+	if in.Network == "" {
+		in.Network = LBNetworkExternal
+	}
 	if in.HealthCheck.Interval == 0 {
 		in.HealthCheck.Interval = DefaultLBHealthCheckInterval
 	}
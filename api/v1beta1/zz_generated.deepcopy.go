@@ -21,7 +21,8 @@ limitations under the License.
 package v1beta1
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/cluster-api/errors"
@@ -47,13 +48,84 @@ func (in *BuildTagParams) DeepCopy() *BuildTagParams {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOAPIServerEndpoint) DeepCopyInto(out *DOAPIServerEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOAPIServerEndpoint.
+func (in *DOAPIServerEndpoint) DeepCopy() *DOAPIServerEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DOAPIServerEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOAPIServerEndpointStatus) DeepCopyInto(out *DOAPIServerEndpointStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOAPIServerEndpointStatus.
+func (in *DOAPIServerEndpointStatus) DeepCopy() *DOAPIServerEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DOAPIServerEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOBastion) DeepCopyInto(out *DOBastion) {
+	*out = *in
+	out.Image = in.Image
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = make([]intstr.IntOrString, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOBastion.
+func (in *DOBastion) DeepCopy() *DOBastion {
+	if in == nil {
+		return nil
+	}
+	out := new(DOBastion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOBastionStatus) DeepCopyInto(out *DOBastionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOBastionStatus.
+func (in *DOBastionStatus) DeepCopy() *DOBastionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DOBastionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOCluster) DeepCopyInto(out *DOCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOCluster.
@@ -109,13 +181,28 @@ func (in *DOClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOClusterSpec) DeepCopyInto(out *DOClusterSpec) {
 	*out = *in
-	out.Network = in.Network
+	in.Network.DeepCopyInto(&out.Network)
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	if in.ControlPlaneDNS != nil {
 		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
 		*out = new(DOControlPlaneDNS)
 		**out = **in
 	}
+	if in.DNSProvider != nil {
+		in, out := &in.DNSProvider, &out.DNSProvider
+		*out = new(DODNSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalDNSRecords != nil {
+		in, out := &in.AdditionalDNSRecords, &out.AdditionalDNSRecords
+		*out = make([]DODNSRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(DOBastion)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOClusterSpec.
@@ -131,7 +218,29 @@ func (in *DOClusterSpec) DeepCopy() *DOClusterSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOClusterStatus) DeepCopyInto(out *DOClusterStatus) {
 	*out = *in
-	out.Network = in.Network
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(DOControlPlaneDNS)
+		**out = **in
+	}
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = make([]DODNSRecordStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.Network.DeepCopyInto(&out.Network)
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(DOBastionStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOClusterStatus.
@@ -249,10 +358,143 @@ func (in *DOControlPlaneDNS) DeepCopy() *DOControlPlaneDNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODNSProvider) DeepCopyInto(out *DODNSProvider) {
+	*out = *in
+	if in.RFC2136 != nil {
+		in, out := &in.RFC2136, &out.RFC2136
+		*out = new(DORFC2136Provider)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODNSProvider.
+func (in *DODNSProvider) DeepCopy() *DODNSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(DODNSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODNSRecord) DeepCopyInto(out *DODNSRecord) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODNSRecord.
+func (in *DODNSRecord) DeepCopy() *DODNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DODNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODNSRecordStatus) DeepCopyInto(out *DODNSRecordStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODNSRecordStatus.
+func (in *DODNSRecordStatus) DeepCopy() *DODNSRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DODNSRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODNSRecordTarget) DeepCopyInto(out *DODNSRecordTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODNSRecordTarget.
+func (in *DODNSRecordTarget) DeepCopy() *DODNSRecordTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DODNSRecordTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOFirewall) DeepCopyInto(out *DOFirewall) {
+	*out = *in
+	if in.AdditionalInboundRules != nil {
+		in, out := &in.AdditionalInboundRules, &out.AdditionalInboundRules
+		*out = make([]DOFirewallInboundRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOFirewall.
+func (in *DOFirewall) DeepCopy() *DOFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(DOFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOFirewallInboundRule) DeepCopyInto(out *DOFirewallInboundRule) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOFirewallInboundRule.
+func (in *DOFirewallInboundRule) DeepCopy() *DOFirewallInboundRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DOFirewallInboundRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOForwardingRule) DeepCopyInto(out *DOForwardingRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOForwardingRule.
+func (in *DOForwardingRule) DeepCopy() *DOForwardingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DOForwardingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOLoadBalancer) DeepCopyInto(out *DOLoadBalancer) {
 	*out = *in
 	out.HealthCheck = in.HealthCheck
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(DOLoadBalancerFirewall)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalForwardingRules != nil {
+		in, out := &in.AdditionalForwardingRules, &out.AdditionalForwardingRules
+		*out = make([]DOForwardingRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOLoadBalancer.
@@ -265,6 +507,31 @@ func (in *DOLoadBalancer) DeepCopy() *DOLoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOLoadBalancerFirewall) DeepCopyInto(out *DOLoadBalancerFirewall) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOLoadBalancerFirewall.
+func (in *DOLoadBalancerFirewall) DeepCopy() *DOLoadBalancerFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(DOLoadBalancerFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOLoadBalancerHealthCheck) DeepCopyInto(out *DOLoadBalancerHealthCheck) {
 	*out = *in
@@ -380,7 +647,7 @@ func (in *DOMachineStatus) DeepCopyInto(out *DOMachineStatus) {
 	*out = *in
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
-		*out = make([]v1.NodeAddress, len(*in))
+		*out = make([]corev1.NodeAddress, len(*in))
 		copy(*out, *in)
 	}
 	if in.Volumes != nil {
@@ -403,6 +670,13 @@ func (in *DOMachineStatus) DeepCopyInto(out *DOMachineStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOMachineStatus.
@@ -508,8 +782,19 @@ func (in *DOMachineTemplateSpec) DeepCopy() *DOMachineTemplateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DONetwork) DeepCopyInto(out *DONetwork) {
 	*out = *in
-	out.APIServerLoadbalancers = in.APIServerLoadbalancers
+	in.APIServerLoadbalancers.DeepCopyInto(&out.APIServerLoadbalancers)
+	if in.APIServerPublicLoadbalancer != nil {
+		in, out := &in.APIServerPublicLoadbalancer, &out.APIServerPublicLoadbalancer
+		*out = new(DOLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	out.APIServerEndpoint = in.APIServerEndpoint
 	out.VPC = in.VPC
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(DOFirewall)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DONetwork.
@@ -526,6 +811,17 @@ func (in *DONetwork) DeepCopy() *DONetwork {
 func (in *DONetworkResource) DeepCopyInto(out *DONetworkResource) {
 	*out = *in
 	out.APIServerLoadbalancersRef = in.APIServerLoadbalancersRef
+	out.VPC = in.VPC
+	out.APIServerFirewallRef = in.APIServerFirewallRef
+	out.NodeFirewallRef = in.NodeFirewallRef
+	out.BastionFirewallRef = in.BastionFirewallRef
+	out.APIServerReservedIP = in.APIServerReservedIP
+	out.APIServerPublicLoadbalancerRef = in.APIServerPublicLoadbalancerRef
+	if in.APIServerEndpoints != nil {
+		in, out := &in.APIServerEndpoints, &out.APIServerEndpoints
+		*out = make([]DOAPIServerEndpointStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DONetworkResource.
@@ -538,6 +834,41 @@ func (in *DONetworkResource) DeepCopy() *DONetworkResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DORFC2136Provider) DeepCopyInto(out *DORFC2136Provider) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DORFC2136Provider.
+func (in *DORFC2136Provider) DeepCopy() *DORFC2136Provider {
+	if in == nil {
+		return nil
+	}
+	out := new(DORFC2136Provider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOReservedIPResource) DeepCopyInto(out *DOReservedIPResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOReservedIPResource.
+func (in *DOReservedIPResource) DeepCopy() *DOReservedIPResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DOReservedIPResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOResourceReference) DeepCopyInto(out *DOResourceReference) {
 	*out = *in
@@ -568,6 +899,21 @@ func (in *DOVPC) DeepCopy() *DOVPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOVPCResource) DeepCopyInto(out *DOVPCResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOVPCResource.
+func (in *DOVPCResource) DeepCopy() *DOVPCResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DOVPCResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOVolume) DeepCopyInto(out *DOVolume) {
 	*out = *in
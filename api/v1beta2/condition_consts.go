@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+// Conditions and condition Reasons shared by the DOCluster and DOMachine objects.
+
+const (
+	// ReadyCondition summarizes the other conditions of the object.
+	ReadyCondition = "Ready"
+
+	// ReadyReason surfaces when a resource is ready.
+	ReadyReason = "Ready"
+	// NotReadyReason surfaces when a resource exists but is not ready yet.
+	NotReadyReason = "NotReady"
+	// ReconciliationFailedReason surfaces when a resource could not be reconciled.
+	ReconciliationFailedReason = "ReconciliationFailed"
+	// DeletingReason surfaces when a resource is being deleted.
+	DeletingReason = "Deleting"
+)
+
+// Conditions and condition Reasons for the DOCluster object.
+
+const (
+	// VPCReadyCondition reports on the VPC of the cluster.
+	VPCReadyCondition = "VPCReady"
+
+	// VPCNotManagedReason surfaces when the VPC is not managed by the provider.
+	VPCNotManagedReason = "NotManaged"
+)
+
+const (
+	// LoadBalancerReadyCondition reports on the API server load balancers.
+	// It is only set when the API server is exposed through load balancers.
+	LoadBalancerReadyCondition = "LoadBalancerReady"
+
+	// LoadBalancerWaitingForIPReason surfaces when a load balancer has no IP address yet.
+	LoadBalancerWaitingForIPReason = "WaitingForIP"
+)
+
+const (
+	// DNSRecordReadyCondition reports on the control plane DNS record and the
+	// additional DNS records of the cluster. It is only set when the cluster
+	// has DNS records.
+	DNSRecordReadyCondition = "DNSRecordReady"
+
+	// DNSRecordNotOwnedReason surfaces when a DNS record is owned by someone else.
+	DNSRecordNotOwnedReason = "NotOwned"
+)
+
+const (
+	// ControlPlaneDNSPropagatedCondition reports the result of the last
+	// propagation check of the control plane DNS record.
+	ControlPlaneDNSPropagatedCondition = "ControlPlaneDNSPropagated"
+
+	// ControlPlaneDNSPropagatedReason surfaces when a quorum of nameservers answered with the record.
+	ControlPlaneDNSPropagatedReason = "Propagated"
+	// ControlPlaneDNSNotPropagatedReason surfaces when too few nameservers answered with the record.
+	ControlPlaneDNSNotPropagatedReason = "NotPropagated"
+	// ControlPlaneDNSPropagationCheckFailedReason surfaces when the propagation check could not be performed.
+	ControlPlaneDNSPropagationCheckFailedReason = "PropagationCheckFailed"
+)
+
+// Conditions and condition Reasons for the DOMachine object.
+
+const (
+	// BootstrapDataReadyCondition reports whether the bootstrap data of the
+	// machine is available.
+	BootstrapDataReadyCondition = "BootstrapDataReady"
+
+	// WaitingForClusterInfrastructureReason surfaces when the cluster infrastructure is not provisioned yet.
+	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
+	// WaitingForBootstrapDataReason surfaces when the bootstrap data secret is not available yet.
+	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+)
+
+const (
+	// VolumesReadyCondition reports on the data disk volumes of the machine.
+	VolumesReadyCondition = "VolumesReady"
+)
+
+const (
+	// InstanceReadyCondition reports on the droplet of the machine.
+	InstanceReadyCondition = "InstanceReady"
+
+	// InstanceProvisioningReason surfaces when the droplet is being created.
+	InstanceProvisioningReason = "Provisioning"
+	// InstancePoweringOnReason surfaces when the droplet was found powered off
+	// and is being powered back on.
+	InstancePoweringOnReason = "PoweringOn"
+	// InstanceNotFoundReason surfaces when the droplet of a provisioned machine
+	// no longer exists.
+	InstanceNotFoundReason = "NotFound"
+	// InstanceUnexpectedStatusReason surfaces when the droplet is in an unexpected status.
+	InstanceUnexpectedStatusReason = "UnexpectedStatus"
+)
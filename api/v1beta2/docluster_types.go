@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	// ClusterFinalizer allows ReconcileDOCluster to clean up DigitalOcean resources associated with DOCluster before
+	// removing it from the apiserver.
+	ClusterFinalizer = "docluster.infrastructure.cluster.x-k8s.io"
+)
+
+// DOClusterSpec defines the desired state of DOCluster.
+type DOClusterSpec struct {
+	// The DigitalOcean Region the cluster lives in. It must be one of available
+	// region on DigitalOcean. See
+	// https://developers.digitalocean.com/documentation/v2/#list-all-regions
+	Region string `json:"region"`
+	// Network configurations
+	// +optional
+	Network DONetwork `json:"network,omitempty"`
+	// ControlPlaneEndpoint represents the endpoint used to communicate with the
+	// control plane. If ControlPlaneDNS is unset, the DO load-balancer IP
+	// of the Kubernetes API Server is used.
+	// +optional
+	ControlPlaneEndpoint clusterv1.APIEndpoint `json:"controlPlaneEndpoint"`
+	// ControlPlaneDNS is a managed DNS name that points to the load-balancer
+	// IP used for the ControlPlaneEndpoint.
+	// +optional
+	ControlPlaneDNS *DOControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+	// DNSProvider configures the DNS provider managing the ControlPlaneDNS
+	// record. Defaults to the DigitalOcean Domains API.
+	// +optional
+	DNSProvider *DODNSProvider `json:"dnsProvider,omitempty"`
+	// AdditionalDNSRecords are extra DNS records managed for the cluster,
+	// e.g. a wildcard record for ingress or records for the control plane
+	// machines.
+	// +optional
+	AdditionalDNSRecords []DODNSRecord `json:"additionalDNSRecords,omitempty"`
+	// Bastion configures a bastion droplet in the cluster VPC that can be
+	// used to reach the cluster droplets over SSH.
+	// +optional
+	Bastion *DOBastion `json:"bastion,omitempty"`
+	// AdditionalTags is an optional set of tags added to all the DigitalOcean
+	// resources of the cluster which support tags, next to the cluster tags:
+	// the droplets, their data disk volumes, the load balancers and the bastion.
+	// Changes are applied to existing resources as well. Firewalls and
+	// reserved IPs can't be tagged on DigitalOcean.
+	// +optional
+	AdditionalTags Tags `json:"additionalTags,omitempty"`
+	// Project is the DigitalOcean Project the droplets, volumes, load
+	// balancers and reserved IPs of the cluster are assigned to. If omitted,
+	// they are placed in the default project of the account.
+	// +optional
+	Project *DOProject `json:"project,omitempty"`
+	// IdentityRef references the DOClusterIdentity holding the DigitalOcean
+	// credentials of the cluster. If omitted, the credentials of the
+	// DIGITALOCEAN_ACCESS_TOKEN environment variable of the manager are used.
+	// +optional
+	IdentityRef *DOClusterIdentityReference `json:"identityRef,omitempty"`
+}
+
+// DOProject references the DigitalOcean Project of the cluster resources.
+type DOProject struct {
+	// ID of an existing project. Mutually exclusive with Name.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Name of the project. It must be unique within the account.
+	// +optional
+	// +kubebuilder:validation:MaxLength=175
+	Name string `json:"name,omitempty"`
+	// Create the project named Name if it does not exist. A created project
+	// is not deleted along with the cluster, as it may be shared with other
+	// clusters.
+	// +optional
+	Create bool `json:"create,omitempty"`
+}
+
+// DOBastion defines the bastion droplet of the cluster.
+type DOBastion struct {
+	// Droplet size. It must be known DigitalOcean droplet size. See https://developers.digitalocean.com/documentation/v2/#list-all-sizes
+	Size string `json:"size"`
+	// Droplet image can be image id or slug. See https://developers.digitalocean.com/documentation/v2/#list-all-images
+	Image intstr.IntOrString `json:"image"`
+	// SSHKeys is the ssh key id or fingerprint to attach in DigitalOcean droplet.
+	// It must be available on DigitalOcean account. See https://developers.digitalocean.com/documentation/v2/#list-all-keys
+	SSHKeys []intstr.IntOrString `json:"sshKeys"`
+	// AllowedCIDRs is the list of CIDRs allowed to connect to the bastion over SSH.
+	// If omitted, SSH is allowed from everywhere.
+	// +optional
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+}
+
+// DOBastionStatus describes the bastion droplet of the cluster.
+type DOBastionStatus struct {
+	// ID of the bastion droplet.
+	// +optional
+	ResourceID string `json:"resourceId,omitempty"`
+	// Status of the bastion droplet.
+	// +optional
+	ResourceStatus DOResourceStatus `json:"resourceStatus,omitempty"`
+	// PublicIP is the public IPv4 address of the bastion droplet.
+	// +optional
+	PublicIP string `json:"publicIP,omitempty"`
+	// PrivateIP is the private IPv4 address of the bastion droplet.
+	// +optional
+	PrivateIP string `json:"privateIP,omitempty"`
+}
+
+// DOClusterStatus defines the observed state of DOCluster.
+type DOClusterStatus struct {
+	// Conditions defines current service state of the DOCluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Initialization provides observations of the DOCluster initialization
+	// process.
+	// +optional
+	Initialization *DOClusterInitializationStatus `json:"initialization,omitempty"`
+	// ProjectID is the ID of the DigitalOcean Project the cluster resources
+	// are assigned to.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+	// AppliedTags are the additional tags of the DOCluster last applied to
+	// the load balancers and the bastion. Only these tags are removed from the
+	// resources when they are dropped from the spec.
+	// +optional
+	AppliedTags Tags `json:"appliedTags,omitempty"`
+	// ControlPlaneDNSRecordReady denotes that the DNS record is ready and
+	// propagated to the DO DNS servers.
+	// +optional
+	ControlPlaneDNSRecordReady bool `json:"controlPlaneDNSRecordReady,omitempty"`
+	// ControlPlaneDNSLastCheckTime is the time the propagation of the control
+	// plane DNS record was last checked.
+	// +optional
+	ControlPlaneDNSLastCheckTime *metav1.Time `json:"controlPlaneDNSLastCheckTime,omitempty"`
+	// ControlPlaneDNS is the DNS record currently managed for the control
+	// plane endpoint. It differs from the spec while the record is being moved
+	// and is used to remove the previous record once the new one is propagated.
+	// +optional
+	ControlPlaneDNS *DOControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+	// DNSRecords are the additional DNS records currently managed for the
+	// cluster.
+	// +optional
+	DNSRecords []DODNSRecordStatus `json:"dnsRecords,omitempty"`
+	// Network encapsulates all things related to DigitalOcean network.
+	// +optional
+	Network DONetworkResource `json:"network,omitempty"`
+	// Bastion describes the bastion droplet of the cluster.
+	// +optional
+	Bastion *DOBastionStatus `json:"bastion,omitempty"`
+}
+
+// DOClusterInitializationStatus provides observations of the DOCluster
+// initialization process.
+type DOClusterInitializationStatus struct {
+	// Provisioned is true when the infrastructure provider reports that the
+	// cluster infrastructure is fully provisioned.
+	// +optional
+	Provisioned *bool `json:"provisioned,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=doclusters,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this DOCluster belongs"
+// +kubebuilder:printcolumn:name="Provisioned",type="string",JSONPath=".status.initialization.provisioned",description="Cluster infrastructure is ready for DigitalOcean droplet instances"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.ControlPlaneEndpoint",description="API Endpoint",priority=1
+
+// DOCluster is the Schema for the DOClusters API.
+type DOCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DOClusterSpec   `json:"spec,omitempty"`
+	Status DOClusterStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (c *DOCluster) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions on this object.
+func (c *DOCluster) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// DOClusterList contains a list of DOCluster.
+type DOClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DOCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DOCluster{}, &DOClusterList{})
+}
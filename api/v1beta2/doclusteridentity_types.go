@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DOClusterIdentityTokenKey is the key of the DigitalOcean access token in
+// the Secret referenced by a DOClusterIdentity.
+const DOClusterIdentityTokenKey = "token"
+
+// DOClusterIdentitySpec defines the desired state of DOClusterIdentity.
+type DOClusterIdentitySpec struct {
+	// SecretRef references the Secret holding the DigitalOcean access token
+	// under the "token" key. To have clusterctl move carry the Secret along
+	// with the identity, label it with clusterctl.cluster.x-k8s.io/move.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+	// AllowedNamespaces selects the namespaces whose DOClusters may use the
+	// identity. An empty allowedNamespaces allows all namespaces, while no
+	// namespace is allowed if it is omitted.
+	// +optional
+	AllowedNamespaces *DOClusterIdentityAllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// DOClusterIdentityAllowedNamespaces selects namespaces by name or by labels.
+// A namespace matching either of them is allowed.
+type DOClusterIdentityAllowedNamespaces struct {
+	// NamespaceList is a list of namespace names.
+	// +optional
+	NamespaceList []string `json:"list,omitempty"`
+	// Selector selects namespaces by their labels. An empty selector selects
+	// all namespaces.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// DOClusterIdentityReference references a DOClusterIdentity.
+type DOClusterIdentityReference struct {
+	// Name of the DOClusterIdentity.
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=doclusteridentities,scope=Cluster,categories=cluster-api,shortName=doci
+// +kubebuilder:metadata:labels=clusterctl.cluster.x-k8s.io/move-hierarchy=true
+
+// DOClusterIdentity is the Schema for the doclusteridentities API. It holds
+// the DigitalOcean credentials of the DOClusters referencing it.
+type DOClusterIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DOClusterIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DOClusterIdentityList contains a list of DOClusterIdentity.
+type DOClusterIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DOClusterIdentity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DOClusterIdentity{}, &DOClusterIdentityList{})
+}
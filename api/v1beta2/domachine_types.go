@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/cluster-api/errors" //nolint:staticcheck
+)
+
+const (
+	// MachineFinalizer allows ReconcileDOMachine to clean up DigitalOcean resources associated with DOMachine before
+	// removing it from the apiserver.
+	MachineFinalizer = "domachine.infrastructure.cluster.x-k8s.io"
+)
+
+// DOMachineSpec defines the desired state of DOMachine.
+type DOMachineSpec struct {
+	// ProviderID is the unique identifier as specified by the cloud provider.
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+	// Droplet size. It must be known DigitalOcean droplet size. See https://developers.digitalocean.com/documentation/v2/#list-all-sizes
+	Size string `json:"size"`
+	// Droplet image can be image id or slug. See https://developers.digitalocean.com/documentation/v2/#list-all-images
+	Image intstr.IntOrString `json:"image"`
+	// DataDisks specifies the parameters that are used to add one or more data disks to the machine
+	DataDisks []DataDisk `json:"dataDisks,omitempty"`
+	// SSHKeys is the ssh key id or fingerprint to attach in DigitalOcean droplet.
+	// It must be available on DigitalOcean account. See https://developers.digitalocean.com/documentation/v2/#list-all-keys
+	SSHKeys []intstr.IntOrString `json:"sshKeys"`
+	// AdditionalTags is an optional set of tags to add to DigitalOcean resources managed by the DigitalOcean provider.
+	// +optional
+	AdditionalTags Tags `json:"additionalTags,omitempty"`
+	// IPFamily of the droplet. It must be either "IPv4" or "DualStack".
+	// Defaults to the DOCluster network ipFamily.
+	// +optional
+	// +kubebuilder:validation:Enum=IPv4;DualStack
+	IPFamily DOIPFamily `json:"ipFamily,omitempty"`
+}
+
+// DOMachineStatus defines the observed state of DOMachine.
+type DOMachineStatus struct {
+	// Conditions defines current service state of the DOMachine.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Initialization provides observations of the DOMachine initialization
+	// process.
+	// +optional
+	Initialization *DOMachineInitializationStatus `json:"initialization,omitempty"`
+
+	// Addresses contains the DigitalOcean droplet associated addresses.
+	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+
+	// Volumes contains the DigitalOcean droplet associated block storage
+	// volumes.
+	Volumes []DOVolume `json:"volumes,omitempty"`
+
+	// InstanceStatus is the status of the DigitalOcean droplet instance for this machine.
+	// +optional
+	InstanceStatus *DOResourceStatus `json:"instanceStatus,omitempty"`
+
+	// PowerOn tracks the attempts to power the droplet back on after it was
+	// found powered off.
+	// +optional
+	PowerOn *DOPowerOnStatus `json:"powerOn,omitempty"`
+
+	// AppliedTags are the additional tags of the DOMachine and its DOCluster
+	// last applied to the droplet and its data disk volumes. Only these tags
+	// are removed from the resources when they are dropped from the spec.
+	// +optional
+	AppliedTags Tags `json:"appliedTags,omitempty"`
+
+	// Deprecated groups all the status fields that are deprecated and will be
+	// removed when support for v1beta1 is dropped.
+	// +optional
+	Deprecated *DOMachineDeprecatedStatus `json:"deprecated,omitempty"`
+}
+
+// DOPowerOnStatus tracks the attempts to power a droplet back on.
+type DOPowerOnStatus struct {
+	// Attempts is the number of power on actions requested for the droplet.
+	Attempts int32 `json:"attempts"`
+	// LastAttemptTime is the time the last power on action was requested.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+}
+
+// DOMachineInitializationStatus provides observations of the DOMachine
+// initialization process.
+type DOMachineInitializationStatus struct {
+	// Provisioned is true when the infrastructure provider reports that the
+	// droplet is fully provisioned.
+	// +optional
+	Provisioned *bool `json:"provisioned,omitempty"`
+}
+
+// DOMachineDeprecatedStatus groups all the status fields that are deprecated
+// and will be removed in a future version.
+type DOMachineDeprecatedStatus struct {
+	// V1Beta1 groups all the status fields that are deprecated and will be
+	// removed when support for v1beta1 is dropped.
+	// +optional
+	V1Beta1 *DOMachineV1Beta1DeprecatedStatus `json:"v1beta1,omitempty"`
+}
+
+// DOMachineV1Beta1DeprecatedStatus groups all the status fields that are
+// deprecated and will be removed when support for v1beta1 is dropped.
+type DOMachineV1Beta1DeprecatedStatus struct {
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the Machine and will contain a succinct value suitable
+	// for machine interpretation.
+	//
+	// Deprecated: This field is deprecated and is going to be removed when
+	// support for v1beta1 is dropped. Please see the Ready condition instead.
+	// +optional
+	FailureReason *errors.MachineStatusError `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the Machine and will contain a more verbose string suitable
+	// for logging and human consumption.
+	//
+	// Deprecated: This field is deprecated and is going to be removed when
+	// support for v1beta1 is dropped. Please see the Ready condition instead.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=domachines,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this DOMachine belongs"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.instanceStatus",description="DigitalOcean droplet instance state"
+// +kubebuilder:printcolumn:name="Provisioned",type="string",JSONPath=".status.initialization.provisioned",description="Machine provisioned status"
+// +kubebuilder:printcolumn:name="InstanceID",type="string",JSONPath=".spec.providerID",description="DigitalOcean droplet instance ID"
+// +kubebuilder:printcolumn:name="Machine",type="string",JSONPath=".metadata.ownerReferences[?(@.kind==\"Machine\")].name",description="Machine object which owns with this DOMachine"
+
+// DOMachine is the Schema for the domachines API.
+type DOMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DOMachineSpec   `json:"spec,omitempty"`
+	Status DOMachineStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (m *DOMachine) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+// SetConditions sets the conditions on this object.
+func (m *DOMachine) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// DOMachineList contains a list of DOMachine.
+type DOMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DOMachine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DOMachine{}, &DOMachineList{})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DORemediationPhase is the remediation step a DORemediation is at.
+type DORemediationPhase string
+
+const (
+	// DORemediationPhasePowerCycling is the phase in which the droplet was
+	// power cycled and the machine is given time to become healthy again.
+	DORemediationPhasePowerCycling = DORemediationPhase("PowerCycling")
+	// DORemediationPhaseRebuilding is the phase in which the droplet was
+	// rebuilt from the machine image and the machine is given time to become
+	// healthy again.
+	DORemediationPhaseRebuilding = DORemediationPhase("Rebuilding")
+	// DORemediationPhaseDeleting is the phase in which the machine was deleted
+	// to be replaced by its owner.
+	DORemediationPhaseDeleting = DORemediationPhase("Deleting")
+)
+
+// DORemediationSpec defines the desired state of DORemediation.
+type DORemediationSpec struct {
+	// PowerCycleTimeout is the time given to the machine to become healthy
+	// after its droplet was power cycled, before the droplet is rebuilt.
+	// Defaults to 5 minutes.
+	// +optional
+	PowerCycleTimeout *metav1.Duration `json:"powerCycleTimeout,omitempty"`
+	// RebuildTimeout is the time given to the machine to become healthy
+	// after its droplet was rebuilt, before the machine is deleted.
+	// Rebuilding keeps the droplet IP addresses and attached volumes.
+	// Defaults to 20 minutes.
+	// +optional
+	RebuildTimeout *metav1.Duration `json:"rebuildTimeout,omitempty"`
+}
+
+// DORemediationStatus defines the observed state of DORemediation.
+type DORemediationStatus struct {
+	// Phase is the remediation step in progress.
+	// +optional
+	Phase DORemediationPhase `json:"phase,omitempty"`
+	// LastStepTime is the time the remediation step in progress was started.
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=doremediations,scope=Namespaced,categories=cluster-api,shortName=dorm
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Remediation step in progress"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of DORemediation"
+
+// DORemediation is the Schema for the doremediations API. It is created by a
+// MachineHealthCheck for an unhealthy Machine and has the name of that
+// Machine.
+type DORemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DORemediationSpec   `json:"spec,omitempty"`
+	Status DORemediationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DORemediationList contains a list of DORemediation.
+type DORemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DORemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DORemediation{}, &DORemediationList{})
+}
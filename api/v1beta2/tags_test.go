@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildTags(t *testing.T) {
+	type args struct {
+		params BuildTagParams
+	}
+	tests := []struct {
+		name string
+		args args
+		want Tags
+	}{
+		{
+			name: "test tags",
+			args: args{
+				params: BuildTagParams{
+					ClusterName: "foo",
+					ClusterUID:  "155bd6ca-c6a9-45a8-8c9c-05e09b36bc42",
+					Name:        "bar",
+					Role:        APIServerRoleTagValue,
+				},
+			},
+			want: Tags{
+				ClusterNameTag("foo"),
+				ClusterNameRoleTag("foo", APIServerRoleTagValue),
+				ClusterNameUIDRoleTag("foo", "155bd6ca-c6a9-45a8-8c9c-05e09b36bc42", APIServerRoleTagValue),
+				NameTagFromName("bar"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildTags(tt.args.params); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BuildTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
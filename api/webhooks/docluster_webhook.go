@@ -20,12 +20,19 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"net"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
-	"sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -35,8 +42,8 @@ import (
 // log is for logging in this package.
 var _ = logf.Log.WithName("docluster-resource")
 
-// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-docluster,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=doclusters,versions=v1beta1,name=validation.docluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
-// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-docluster,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=doclusters,versions=v1beta1,name=default.docluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-docluster,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=doclusters,versions=v1beta2,name=validation.docluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-docluster,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=doclusters,versions=v1beta2,name=default.docluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
 
 // DOClusterWebhook is a collection of webhooks for DOCluster objects.
 type DOClusterWebhook struct{}
@@ -48,7 +55,7 @@ var (
 
 func (w *DOClusterWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
-		For(&v1beta1.DOCluster{}).
+		For(&v1beta2.DOCluster{}).
 		WithDefaulter(&DOClusterWebhook{}).
 		WithValidator(&DOClusterWebhook{}).
 		Complete()
@@ -60,20 +67,30 @@ func (w *DOClusterWebhook) Default(context.Context, runtime.Object) error {
 }
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
-func (w *DOClusterWebhook) ValidateCreate(context.Context, runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+func (w *DOClusterWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	doCluster, ok := obj.(*v1beta2.DOCluster)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an DOCluster object but got a %T", obj))
+	}
+
+	warnings, allErrs := validateDOClusterSpec(&doCluster.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+
+	return warnings, apierrors.NewInvalid(doCluster.GroupVersionKind().GroupKind(), doCluster.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
 func (w *DOClusterWebhook) ValidateUpdate(_ context.Context, objOld, objNew runtime.Object) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 
-	oldDOCluster, ok := objOld.(*v1beta1.DOCluster)
+	oldDOCluster, ok := objOld.(*v1beta2.DOCluster)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an DOCluster old object but got a %T", objOld))
 	}
 
-	newDOCluster, ok := objNew.(*v1beta1.DOCluster)
+	newDOCluster, ok := objNew.(*v1beta2.DOCluster)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an DOCluster new object but got a %T", objNew))
 	}
@@ -82,14 +99,336 @@ func (w *DOClusterWebhook) ValidateUpdate(_ context.Context, objOld, objNew runt
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "region"), newDOCluster.Spec.Region, "field is immutable"))
 	}
 
+	if newDOCluster.Spec.Network.APIServerEndpoint != oldDOCluster.Spec.Network.APIServerEndpoint {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "apiServerEndpoint"), newDOCluster.Spec.Network.APIServerEndpoint, "field is immutable"))
+	}
+
+	// The load balancer ID is set once it is created or adopted and must not change afterwards.
+	if oldID, newID := oldDOCluster.Spec.Network.APIServerLoadbalancers.ResourceID, newDOCluster.Spec.Network.APIServerLoadbalancers.ResourceID; oldID != "" && newID != oldID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "apiServerLoadbalancers", "resourceId"), newID, "field is immutable"))
+	}
+	if oldPublic, newPublic := oldDOCluster.Spec.Network.APIServerPublicLoadbalancer, newDOCluster.Spec.Network.APIServerPublicLoadbalancer; oldPublic != nil && newPublic != nil &&
+		oldPublic.ResourceID != "" && newPublic.ResourceID != oldPublic.ResourceID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "apiServerPublicLoadbalancer", "resourceId"), newPublic.ResourceID, "field is immutable"))
+	}
+
+	if lbNetwork(&newDOCluster.Spec.Network.APIServerLoadbalancers) != lbNetwork(&oldDOCluster.Spec.Network.APIServerLoadbalancers) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "apiServerLoadbalancers", "network"), newDOCluster.Spec.Network.APIServerLoadbalancers.Network, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(newDOCluster.Spec.Network.VPC, oldDOCluster.Spec.Network.VPC) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "vpc"), newDOCluster.Spec.Network.VPC, "field is immutable"))
+	}
+
+	if ipFamily(&newDOCluster.Spec.Network) != ipFamily(&oldDOCluster.Spec.Network) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "ipFamily"), newDOCluster.Spec.Network.IPFamily, "field is immutable"))
+	}
+
+	// The bastion droplet is not recreated on changes, only its allowed CIDRs may be updated.
+	if oldBastion, newBastion := oldDOCluster.Spec.Bastion, newDOCluster.Spec.Bastion; oldBastion != nil && newBastion != nil {
+		bastionPath := field.NewPath("spec", "bastion")
+		if newBastion.Size != oldBastion.Size {
+			allErrs = append(allErrs, field.Invalid(bastionPath.Child("size"), newBastion.Size, "field is immutable"))
+		}
+		if newBastion.Image != oldBastion.Image {
+			allErrs = append(allErrs, field.Invalid(bastionPath.Child("image"), newBastion.Image, "field is immutable"))
+		}
+		if !reflect.DeepEqual(newBastion.SSHKeys, oldBastion.SSHKeys) {
+			allErrs = append(allErrs, field.Invalid(bastionPath.Child("sshKeys"), newBastion.SSHKeys, "field is immutable"))
+		}
+	}
+
+	warnings, specErrs := validateDOClusterSpec(&newDOCluster.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, specErrs...)
+
+	// The DNS record is moved by the controller, but the control plane endpoint
+	// of the Cluster and the API server certificates keep the previous name.
+	if !reflect.DeepEqual(newDOCluster.Spec.ControlPlaneDNS, oldDOCluster.Spec.ControlPlaneDNS) && oldDOCluster.Spec.ControlPlaneEndpoint.IsValid() {
+		warnings = append(warnings, fmt.Sprintf("spec.controlPlaneDNS changed after the control plane endpoint %s was set: "+
+			"the DNS record will be moved, but the control plane endpoint cannot move once the control plane is initialized",
+			oldDOCluster.Spec.ControlPlaneEndpoint.Host))
+	}
+
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(newDOCluster.GroupVersionKind().GroupKind(), newDOCluster.Name, allErrs)
+	return warnings, apierrors.NewInvalid(newDOCluster.GroupVersionKind().GroupKind(), newDOCluster.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
 func (w *DOClusterWebhook) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
 	return nil, nil
 }
+
+// validateDOClusterSpec validates the DOCluster spec fields which apply on both create and update.
+func validateDOClusterSpec(spec *v1beta2.DOClusterSpec, fldPath *field.Path) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+
+	vpcPath := fldPath.Child("network", "vpc")
+	vpc := spec.Network.VPC
+	if vpc.VPCUUID != "" && (vpc.Name != "" || vpc.IPRange != "" || vpc.Description != "") {
+		allErrs = append(allErrs, field.Forbidden(vpcPath, "vpcUUID cannot be combined with name, ipRange or description"))
+	}
+	if vpc.IPRange != "" {
+		if _, _, err := net.ParseCIDR(vpc.IPRange); err != nil {
+			allErrs = append(allErrs, field.Invalid(vpcPath.Child("ipRange"), vpc.IPRange, "must be a valid CIDR"))
+		}
+	}
+
+	if spec.Network.APIServerEndpoint.Type == v1beta2.DOAPIServerEndpointTypeReservedIP && spec.Network.APIServerLoadbalancers.ResourceID != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("network", "apiServerLoadbalancers", "resourceId"), "a load balancer cannot be used with the ReservedIP API server endpoint type"))
+	}
+
+	warnings, lbErrs := validateDOLoadBalancer(&spec.Network.APIServerLoadbalancers, fldPath.Child("network", "apiServerLoadbalancers"))
+	allErrs = append(allErrs, lbErrs...)
+
+	publicPath := fldPath.Child("network", "apiServerPublicLoadbalancer")
+	if public := spec.Network.APIServerPublicLoadbalancer; public != nil {
+		publicWarnings, publicErrs := validateDOLoadBalancer(public, publicPath)
+		warnings = append(warnings, publicWarnings...)
+		allErrs = append(allErrs, publicErrs...)
+
+		if public.Network != "" && public.Network != v1beta2.LBNetworkExternal {
+			allErrs = append(allErrs, field.Invalid(publicPath.Child("network"), public.Network, "must be EXTERNAL"))
+		}
+		if lbNetwork(&spec.Network.APIServerLoadbalancers) != v1beta2.LBNetworkInternal {
+			allErrs = append(allErrs, field.Forbidden(publicPath, "requires apiServerLoadbalancers.network to be INTERNAL"))
+		}
+		if spec.Network.APIServerEndpoint.Type == v1beta2.DOAPIServerEndpointTypeReservedIP {
+			allErrs = append(allErrs, field.Forbidden(publicPath, "a load balancer cannot be used with the ReservedIP API server endpoint type"))
+		}
+	} else if spec.Network.APIServerEndpoint.Primary == v1beta2.DOEndpointVisibilityPublic && lbNetwork(&spec.Network.APIServerLoadbalancers) == v1beta2.LBNetworkInternal {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("network", "apiServerEndpoint", "primary"), "a public endpoint requires apiServerPublicLoadbalancer when apiServerLoadbalancers is INTERNAL"))
+	}
+
+	if spec.Network.Firewall != nil {
+		allErrs = append(allErrs, validateDOFirewall(spec.Network.Firewall, fldPath.Child("network", "firewall"))...)
+	}
+
+	if provider := spec.DNSProvider; provider != nil {
+		providerPath := fldPath.Child("dnsProvider")
+		switch {
+		case provider.Type == v1beta2.DODNSProviderRFC2136 && provider.RFC2136 == nil:
+			allErrs = append(allErrs, field.Required(providerPath.Child("rfc2136"), "required when type is rfc2136"))
+		case provider.Type != v1beta2.DODNSProviderRFC2136 && provider.RFC2136 != nil:
+			allErrs = append(allErrs, field.Forbidden(providerPath.Child("rfc2136"), "only allowed when type is rfc2136"))
+		case provider.RFC2136 != nil && provider.RFC2136.Server == "":
+			allErrs = append(allErrs, field.Required(providerPath.Child("rfc2136", "server"), "server is required"))
+		}
+	}
+
+	allErrs = append(allErrs, validateDODNSRecords(spec, fldPath.Child("additionalDNSRecords"))...)
+
+	if project := spec.Project; project != nil {
+		projectPath := fldPath.Child("project")
+		switch {
+		case project.ID == "" && project.Name == "":
+			allErrs = append(allErrs, field.Required(projectPath, "one of id or name is required"))
+		case project.ID != "" && project.Name != "":
+			allErrs = append(allErrs, field.Forbidden(projectPath, "id cannot be combined with name"))
+		case project.ID != "" && project.Create:
+			allErrs = append(allErrs, field.Forbidden(projectPath.Child("create"), "only allowed with name"))
+		}
+	}
+
+	if spec.Bastion != nil {
+		for i, cidr := range spec.Bastion.AllowedCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("bastion", "allowedCIDRs").Index(i), cidr, "must be a valid CIDR"))
+			}
+		}
+	}
+
+	return warnings, allErrs
+}
+
+// validateDOLoadBalancer validates the combinations of the API server load balancer options.
+func validateDOLoadBalancer(lb *v1beta2.DOLoadBalancer, fldPath *field.Path) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+
+	apiPort := lb.Port
+	if apiPort == 0 {
+		apiPort = v1beta2.DefaultLBPort
+	}
+
+	entryPorts := map[int32]bool{apiPort: true}
+	hasHTTPRule := false
+	for i, rule := range lb.AdditionalForwardingRules {
+		if entryPorts[rule.EntryPort] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("additionalForwardingRules").Index(i).Child("entryPort"), rule.EntryPort))
+		}
+		entryPorts[rule.EntryPort] = true
+		if rule.Protocol == "http" {
+			hasHTTPRule = true
+		}
+	}
+
+	if lb.HTTPIdleTimeoutSeconds != 0 && !hasHTTPRule {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("httpIdleTimeoutSeconds"), "requires an http forwarding rule"))
+	}
+
+	if lb.EnableProxyProtocol {
+		warnings = append(warnings, fmt.Sprintf("%s: the PROXY protocol header is also sent to kube-apiserver, which does not support it", fldPath.Child("enableProxyProtocol")))
+	}
+
+	if lb.Firewall != nil {
+		fwPath := fldPath.Child("firewall")
+		allowed := map[string]bool{}
+		for i, src := range lb.Firewall.Allow {
+			if !validIPOrCIDR(src) {
+				allErrs = append(allErrs, field.Invalid(fwPath.Child("allow").Index(i), src, "must be a valid IP address or CIDR"))
+			}
+			allowed[src] = true
+		}
+		for i, src := range lb.Firewall.Deny {
+			if !validIPOrCIDR(src) {
+				allErrs = append(allErrs, field.Invalid(fwPath.Child("deny").Index(i), src, "must be a valid IP address or CIDR"))
+			}
+			if allowed[src] {
+				allErrs = append(allErrs, field.Invalid(fwPath.Child("deny").Index(i), src, "cannot be both allowed and denied"))
+			}
+		}
+	}
+
+	return warnings, allErrs
+}
+
+// validateDOFirewall validates the additional inbound rules of the cluster firewalls.
+func validateDOFirewall(fw *v1beta2.DOFirewall, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, rule := range fw.AdditionalInboundRules {
+		rulePath := fldPath.Child("additionalInboundRules").Index(i)
+		if rule.Ports != "" && rule.Ports != "all" && !validPortRange(rule.Ports) {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("ports"), rule.Ports, "must be a port, a port range or \"all\""))
+		}
+		for j, src := range rule.Sources {
+			if !validIPOrCIDR(src) {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("sources").Index(j), src, "must be a valid IP address or CIDR"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// lbNetwork returns the network of the load balancer, defaulting to EXTERNAL.
+func lbNetwork(lb *v1beta2.DOLoadBalancer) string {
+	if lb.Network == "" {
+		return v1beta2.LBNetworkExternal
+	}
+	return lb.Network
+}
+
+// ipFamily returns the IP family of the network, defaulting to IPv4.
+func ipFamily(network *v1beta2.DONetwork) v1beta2.DOIPFamily {
+	if network.IPFamily == "" {
+		return v1beta2.DOIPFamilyIPv4
+	}
+	return network.IPFamily
+}
+
+// validIPOrCIDR returns true if src is an IP address or a CIDR.
+func validIPOrCIDR(src string) bool {
+	if net.ParseIP(src) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(src)
+	return err == nil
+}
+
+// validPortRange returns true if ports is a single port or a range such as "8000-9000".
+func validPortRange(ports string) bool {
+	from, to, isRange := strings.Cut(ports, "-")
+	if !isRange {
+		to = from
+	}
+
+	start, err := strconv.Atoi(from)
+	if err != nil || start < 1 || start > 65535 {
+		return false
+	}
+	end, err := strconv.Atoi(to)
+	if err != nil || end < start || end > 65535 {
+		return false
+	}
+
+	return true
+}
+
+// validateDODNSRecords validates the additional DNS records of a DOCluster.
+func validateDODNSRecords(spec *v1beta2.DOClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Record types by FQDN. The control plane DNS name is managed as both an A
+	// and an AAAA record.
+	names := map[string][]string{}
+	if spec.ControlPlaneDNS != nil {
+		names[spec.ControlPlaneDNS.Name+"."+spec.ControlPlaneDNS.Domain] = []string{"A", "AAAA"}
+	}
+	for i := range spec.AdditionalDNSRecords {
+		record := &spec.AdditionalDNSRecords[i]
+		recordPath := fldPath.Index(i)
+
+		domain := record.Domain
+		if domain == "" {
+			if spec.ControlPlaneDNS == nil {
+				allErrs = append(allErrs, field.Required(recordPath.Child("domain"), "domain is required when controlPlaneDNS is not set"))
+			} else {
+				domain = spec.ControlPlaneDNS.Domain
+			}
+		}
+		fqdn, recordType := record.Name+"."+domain, record.RecordType()
+		switch types := names[fqdn]; {
+		case slices.Contains(types, recordType):
+			allErrs = append(allErrs, field.Duplicate(recordPath.Child("name"), record.Name))
+		case len(types) > 0 && (recordType == "CNAME" || slices.Contains(types, "CNAME")):
+			allErrs = append(allErrs, field.Invalid(recordPath.Child("name"), record.Name, "a CNAME record cannot share its name with another record"))
+		}
+		names[fqdn] = append(names[fqdn], recordType)
+
+		targetPath := recordPath.Child("target")
+		switch record.Target.Type {
+		case v1beta2.DODNSRecordTargetValue:
+			switch {
+			case record.Target.Value == "":
+				allErrs = append(allErrs, field.Required(targetPath.Child("value"), "value is required for Value targets"))
+			case record.RecordType() == "A" && net.ParseIP(record.Target.Value).To4() == nil:
+				allErrs = append(allErrs, field.Invalid(targetPath.Child("value"), record.Target.Value, "must be an IPv4 address for A records"))
+			case record.RecordType() == "AAAA" && (net.ParseIP(record.Target.Value) == nil || net.ParseIP(record.Target.Value).To4() != nil):
+				allErrs = append(allErrs, field.Invalid(targetPath.Child("value"), record.Target.Value, "must be an IPv6 address for AAAA records"))
+			case record.RecordType() == "CNAME":
+				for _, msg := range validation.IsDNS1123Subdomain(strings.TrimSuffix(record.Target.Value, ".")) {
+					allErrs = append(allErrs, field.Invalid(targetPath.Child("value"), record.Target.Value, msg))
+				}
+			}
+		default:
+			if record.Target.Value != "" {
+				allErrs = append(allErrs, field.Forbidden(targetPath.Child("value"), "only allowed for Value targets"))
+			}
+			switch record.RecordType() {
+			case "A":
+			case "AAAA":
+				if ipFamily(&spec.Network) != v1beta2.DOIPFamilyDualStack {
+					allErrs = append(allErrs, field.Invalid(recordPath.Child("type"), record.Type, "AAAA records require a DualStack network for APIServer and ControlPlaneMachines targets"))
+				}
+				if record.Target.AddressType == corev1.NodeInternalIP {
+					allErrs = append(allErrs, field.Invalid(targetPath.Child("addressType"), record.Target.AddressType, "droplets have no internal IPv6 address"))
+				}
+			default:
+				allErrs = append(allErrs, field.Invalid(recordPath.Child("type"), record.Type, "must be A or AAAA for APIServer and ControlPlaneMachines targets"))
+			}
+		}
+
+		if record.Target.AddressType != "" && record.Target.Type != v1beta2.DODNSRecordTargetControlPlaneMachines {
+			allErrs = append(allErrs, field.Forbidden(targetPath.Child("addressType"), "only allowed for ControlPlaneMachines targets"))
+		}
+		if record.Target.Type == v1beta2.DODNSRecordTargetControlPlaneMachines && strings.Contains(record.Name, "*") {
+			allErrs = append(allErrs, field.Invalid(recordPath.Child("name"), record.Name, "cannot be a wildcard for ControlPlaneMachines targets"))
+		}
+	}
+
+	return allErrs
+}
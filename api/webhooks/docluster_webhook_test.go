@@ -0,0 +1,562 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// errorFields returns the fields of the causes of an admission error.
+func errorFields(err error) []string {
+	var statusErr *apierrors.StatusError
+	if !errors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return nil
+	}
+	var fields []string
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	return fields
+}
+
+var testBastion = v1beta2.DOBastion{
+	Size:    "s-1vcpu-1gb",
+	Image:   intstr.FromString("ubuntu-24-04-x64"),
+	SSHKeys: []intstr.IntOrString{intstr.FromInt32(1234)},
+}
+
+func TestDOClusterWebhook_ValidateCreate(t *testing.T) {
+	apiDNS := &v1beta2.DOControlPlaneDNS{Domain: "example.com", Name: "api"}
+	apiServerTarget := v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetAPIServer}
+
+	tests := []struct {
+		name         string
+		spec         v1beta2.DOClusterSpec
+		wantErrs     []string
+		wantWarnings int
+	}{
+		{
+			name: "empty spec",
+		},
+		{
+			name: "vpcUUID combined with a managed VPC",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				VPC: v1beta2.DOVPC{VPCUUID: "vpc-1", Name: "capdo"},
+			}},
+			wantErrs: []string{"spec.network.vpc"},
+		},
+		{
+			name: "invalid VPC ipRange",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				VPC: v1beta2.DOVPC{IPRange: "10.10.0.0"},
+			}},
+			wantErrs: []string{"spec.network.vpc.ipRange"},
+		},
+		{
+			name: "load balancer with the ReservedIP endpoint type",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerEndpoint:      v1beta2.DOAPIServerEndpoint{Type: v1beta2.DOAPIServerEndpointTypeReservedIP},
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{ResourceID: "lb-1"},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.resourceId"},
+		},
+		{
+			name: "forwarding rules reusing an entry port",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{AdditionalForwardingRules: []v1beta2.DOForwardingRule{
+					{Protocol: "tcp", EntryPort: v1beta2.DefaultLBPort},
+					{Protocol: "tcp", EntryPort: 80},
+					{Protocol: "tcp", EntryPort: 80},
+				}},
+			}},
+			wantErrs: []string{
+				"spec.network.apiServerLoadbalancers.additionalForwardingRules[0].entryPort",
+				"spec.network.apiServerLoadbalancers.additionalForwardingRules[2].entryPort",
+			},
+		},
+		{
+			name: "HTTP idle timeout without http forwarding rule",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{
+					HTTPIdleTimeoutSeconds:    60,
+					AdditionalForwardingRules: []v1beta2.DOForwardingRule{{Protocol: "tcp", EntryPort: 80}},
+				},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.httpIdleTimeoutSeconds"},
+		},
+		{
+			name: "HTTP idle timeout with an http forwarding rule",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{
+					HTTPIdleTimeoutSeconds:    60,
+					AdditionalForwardingRules: []v1beta2.DOForwardingRule{{Protocol: "http", EntryPort: 80}},
+				},
+			}},
+		},
+		{
+			name: "PROXY protocol",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{EnableProxyProtocol: true},
+			}},
+			wantWarnings: 1,
+		},
+		{
+			name: "invalid load balancer firewall sources",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{Firewall: &v1beta2.DOLoadBalancerFirewall{
+					Allow: []string{"192.0.2.1", "not-an-ip"},
+					Deny:  []string{"198.51.100.0/24", "198.51.100.0/33"},
+				}},
+			}},
+			wantErrs: []string{
+				"spec.network.apiServerLoadbalancers.firewall.allow[1]",
+				"spec.network.apiServerLoadbalancers.firewall.deny[1]",
+			},
+		},
+		{
+			name: "source both allowed and denied",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{Firewall: &v1beta2.DOLoadBalancerFirewall{
+					Allow: []string{"192.0.2.0/24"},
+					Deny:  []string{"192.0.2.0/24"},
+				}},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.firewall.deny[0]"},
+		},
+		{
+			name: "internal load balancer with a public one",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerEndpoint:           v1beta2.DOAPIServerEndpoint{Primary: v1beta2.DOEndpointVisibilityPublic},
+				APIServerLoadbalancers:      v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkExternal},
+			}},
+		},
+		{
+			name: "internal public load balancer",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers:      v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+			}},
+			wantErrs: []string{"spec.network.apiServerPublicLoadbalancer.network"},
+		},
+		{
+			name: "public load balancer with an external load balancer",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{},
+			}},
+			wantErrs: []string{"spec.network.apiServerPublicLoadbalancer"},
+		},
+		{
+			name: "public load balancer with the ReservedIP endpoint type",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerEndpoint:           v1beta2.DOAPIServerEndpoint{Type: v1beta2.DOAPIServerEndpointTypeReservedIP},
+				APIServerLoadbalancers:      v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{},
+			}},
+			wantErrs: []string{"spec.network.apiServerPublicLoadbalancer"},
+		},
+		{
+			name: "public load balancer options are validated",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers:      v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{EnableProxyProtocol: true, HTTPIdleTimeoutSeconds: 60},
+			}},
+			wantErrs:     []string{"spec.network.apiServerPublicLoadbalancer.httpIdleTimeoutSeconds"},
+			wantWarnings: 1,
+		},
+		{
+			name: "public primary endpoint without public load balancer",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerEndpoint:      v1beta2.DOAPIServerEndpoint{Primary: v1beta2.DOEndpointVisibilityPublic},
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+			}},
+			wantErrs: []string{"spec.network.apiServerEndpoint.primary"},
+		},
+		{
+			name: "firewall rules",
+			spec: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				Firewall: &v1beta2.DOFirewall{AdditionalInboundRules: []v1beta2.DOFirewallInboundRule{
+					{Protocol: "tcp", Ports: "22", Sources: []string{"192.0.2.1"}},
+					{Protocol: "tcp", Ports: "8000-9000", Sources: []string{"192.0.2.0/24"}},
+					{Protocol: "udp", Ports: "all", Sources: []string{"2001:db8::/32"}},
+					{Protocol: "icmp", Sources: []string{"0.0.0.0/0"}},
+					{Protocol: "tcp", Ports: "ssh"},
+					{Protocol: "tcp", Ports: "0"},
+					{Protocol: "tcp", Ports: "65536"},
+					{Protocol: "tcp", Ports: "9000-8000"},
+					{Protocol: "tcp", Ports: "8000-"},
+					{Protocol: "tcp", Ports: "22", Sources: []string{"192.0.2.1", "example.com"}},
+				}},
+			}},
+			wantErrs: []string{
+				"spec.network.firewall.additionalInboundRules[4].ports",
+				"spec.network.firewall.additionalInboundRules[5].ports",
+				"spec.network.firewall.additionalInboundRules[6].ports",
+				"spec.network.firewall.additionalInboundRules[7].ports",
+				"spec.network.firewall.additionalInboundRules[8].ports",
+				"spec.network.firewall.additionalInboundRules[9].sources[1]",
+			},
+		},
+		{
+			name: "rfc2136 DNS provider",
+			spec: v1beta2.DOClusterSpec{DNSProvider: &v1beta2.DODNSProvider{
+				Type:    v1beta2.DODNSProviderRFC2136,
+				RFC2136: &v1beta2.DORFC2136Provider{Server: "192.0.2.53:53"},
+			}},
+		},
+		{
+			name:     "rfc2136 DNS provider without configuration",
+			spec:     v1beta2.DOClusterSpec{DNSProvider: &v1beta2.DODNSProvider{Type: v1beta2.DODNSProviderRFC2136}},
+			wantErrs: []string{"spec.dnsProvider.rfc2136"},
+		},
+		{
+			name: "rfc2136 configuration with the digitalocean DNS provider",
+			spec: v1beta2.DOClusterSpec{DNSProvider: &v1beta2.DODNSProvider{
+				Type:    v1beta2.DODNSProviderDigitalOcean,
+				RFC2136: &v1beta2.DORFC2136Provider{Server: "192.0.2.53:53"},
+			}},
+			wantErrs: []string{"spec.dnsProvider.rfc2136"},
+		},
+		{
+			name: "rfc2136 DNS provider without server",
+			spec: v1beta2.DOClusterSpec{DNSProvider: &v1beta2.DODNSProvider{
+				Type:    v1beta2.DODNSProviderRFC2136,
+				RFC2136: &v1beta2.DORFC2136Provider{},
+			}},
+			wantErrs: []string{"spec.dnsProvider.rfc2136.server"},
+		},
+		{
+			name: "DNS records",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "*.apps", Target: apiServerTarget},
+				{Name: "*.apps", Domain: "example.org", Target: apiServerTarget},
+				{Name: "cp", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetControlPlaneMachines, AddressType: corev1.NodeInternalIP}},
+				{Name: "a", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "192.0.2.1"}},
+				{Name: "docs", Type: "CNAME", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "docs.example.com."}},
+			}},
+		},
+		{
+			name: "DNS record without domain",
+			spec: v1beta2.DOClusterSpec{AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "*.apps", Target: apiServerTarget},
+			}},
+			wantErrs: []string{"spec.additionalDNSRecords[0].domain"},
+		},
+		{
+			name: "duplicate DNS records",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "api", Target: apiServerTarget},
+				{Name: "*.apps", Target: apiServerTarget},
+				{Name: "*.apps", Domain: "example.com", Target: apiServerTarget},
+			}},
+			wantErrs: []string{"spec.additionalDNSRecords[0].name", "spec.additionalDNSRecords[2].name"},
+		},
+		{
+			name: "DNS records of different types with the same name",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, Network: v1beta2.DONetwork{IPFamily: v1beta2.DOIPFamilyDualStack}, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "*.apps", Target: apiServerTarget},
+				{Name: "*.apps", Type: "AAAA", Target: apiServerTarget},
+			}},
+		},
+		{
+			name: "CNAME DNS records sharing their name",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "api", Type: "CNAME", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "lb.example.org"}},
+				{Name: "docs", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "192.0.2.1"}},
+				{Name: "docs", Type: "CNAME", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "docs.example.org"}},
+			}},
+			wantErrs: []string{"spec.additionalDNSRecords[0].name", "spec.additionalDNSRecords[2].name"},
+		},
+		{
+			name: "invalid DNS record values",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "empty", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue}},
+				{Name: "a", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "2001:db8::1"}},
+				{Name: "cname", Type: "CNAME", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "not_a_name"}},
+			}},
+			wantErrs: []string{
+				"spec.additionalDNSRecords[0].target.value",
+				"spec.additionalDNSRecords[1].target.value",
+				"spec.additionalDNSRecords[2].target.value",
+			},
+		},
+		{
+			name: "invalid DNS record targets",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "value", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetAPIServer, Value: "192.0.2.1"}},
+				{Name: "cname", Type: "CNAME", Target: apiServerTarget},
+				{Name: "address", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetAPIServer, AddressType: corev1.NodeExternalIP}},
+				{Name: "*.cp", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetControlPlaneMachines}},
+			}},
+			wantErrs: []string{
+				"spec.additionalDNSRecords[0].target.value",
+				"spec.additionalDNSRecords[1].type",
+				"spec.additionalDNSRecords[2].target.addressType",
+				"spec.additionalDNSRecords[3].name",
+			},
+		},
+		{
+			name: "AAAA DNS records",
+			spec: v1beta2.DOClusterSpec{
+				ControlPlaneDNS: apiDNS,
+				Network:         v1beta2.DONetwork{IPFamily: v1beta2.DOIPFamilyDualStack},
+				AdditionalDNSRecords: []v1beta2.DODNSRecord{
+					{Name: "*.apps", Type: "AAAA", Target: apiServerTarget},
+					{Name: "cp", Type: "AAAA", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetControlPlaneMachines}},
+					{Name: "aaaa", Type: "AAAA", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "2001:db8::1"}},
+				},
+			},
+		},
+		{
+			name: "invalid AAAA DNS records",
+			spec: v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, AdditionalDNSRecords: []v1beta2.DODNSRecord{
+				{Name: "aaaa", Type: "AAAA", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetValue, Value: "192.0.2.1"}},
+				{Name: "*.apps", Type: "AAAA", Target: apiServerTarget},
+				{Name: "cp", Type: "AAAA", Target: v1beta2.DODNSRecordTarget{Type: v1beta2.DODNSRecordTargetControlPlaneMachines, AddressType: corev1.NodeInternalIP}},
+			}},
+			wantErrs: []string{
+				"spec.additionalDNSRecords[0].target.value",
+				"spec.additionalDNSRecords[1].type",
+				"spec.additionalDNSRecords[2].type",
+				"spec.additionalDNSRecords[2].target.addressType",
+			},
+		},
+		{
+			name: "project by ID",
+			spec: v1beta2.DOClusterSpec{Project: &v1beta2.DOProject{ID: "p-1"}},
+		},
+		{
+			name: "project created by name",
+			spec: v1beta2.DOClusterSpec{Project: &v1beta2.DOProject{Name: "team", Create: true}},
+		},
+		{
+			name:     "project without ID or name",
+			spec:     v1beta2.DOClusterSpec{Project: &v1beta2.DOProject{}},
+			wantErrs: []string{"spec.project"},
+		},
+		{
+			name:     "project with ID and name",
+			spec:     v1beta2.DOClusterSpec{Project: &v1beta2.DOProject{ID: "p-1", Name: "team"}},
+			wantErrs: []string{"spec.project"},
+		},
+		{
+			name:     "project created by ID",
+			spec:     v1beta2.DOClusterSpec{Project: &v1beta2.DOProject{ID: "p-1", Create: true}},
+			wantErrs: []string{"spec.project.create"},
+		},
+		{
+			name: "invalid bastion allowed CIDRs",
+			spec: v1beta2.DOClusterSpec{Bastion: &v1beta2.DOBastion{
+				Size:         testBastion.Size,
+				Image:        testBastion.Image,
+				SSHKeys:      testBastion.SSHKeys,
+				AllowedCIDRs: []string{"192.0.2.0/24", "192.0.2.1"},
+			}},
+			wantErrs: []string{"spec.bastion.allowedCIDRs[1]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &DOClusterWebhook{}
+			warnings, err := w.ValidateCreate(context.TODO(), &v1beta2.DOCluster{Spec: tt.spec})
+			if (err != nil) != (len(tt.wantErrs) > 0) {
+				t.Fatalf("ValidateCreate() error = %v, want errors on %v", err, tt.wantErrs)
+			}
+			if got := errorFields(err); !reflect.DeepEqual(got, tt.wantErrs) {
+				t.Errorf("ValidateCreate() errors on %v, want %v", got, tt.wantErrs)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("ValidateCreate() warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestDOClusterWebhook_ValidateUpdate(t *testing.T) {
+	otherBastion := testBastion
+	otherBastion.Size = "s-2vcpu-2gb"
+	otherBastion.Image = intstr.FromInt32(1234)
+	otherBastion.SSHKeys = []intstr.IntOrString{intstr.FromString("aa:bb")}
+	restrictedBastion := testBastion
+	restrictedBastion.AllowedCIDRs = []string{"192.0.2.0/24"}
+	apiDNS := &v1beta2.DOControlPlaneDNS{Domain: "example.com", Name: "api"}
+	k8sDNS := &v1beta2.DOControlPlaneDNS{Domain: "example.com", Name: "k8s"}
+	endpoint := clusterv1beta2.APIEndpoint{Host: "api.example.com", Port: 6443}
+
+	tests := []struct {
+		name         string
+		old          v1beta2.DOClusterSpec
+		new          v1beta2.DOClusterSpec
+		wantErrs     []string
+		wantWarnings int
+	}{
+		{
+			name: "mutable fields",
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{SizeUnit: 2},
+			}},
+		},
+		{
+			name:     "region",
+			old:      v1beta2.DOClusterSpec{Region: "nyc1"},
+			new:      v1beta2.DOClusterSpec{Region: "ams3"},
+			wantErrs: []string{"spec.region"},
+		},
+		{
+			name: "API server endpoint",
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerEndpoint: v1beta2.DOAPIServerEndpoint{Type: v1beta2.DOAPIServerEndpointTypeReservedIP},
+			}},
+			wantErrs: []string{"spec.network.apiServerEndpoint"},
+		},
+		{
+			name: "load balancer ID set once created",
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{ResourceID: "lb-1"},
+			}},
+		},
+		{
+			name: "load balancer ID",
+			old: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{ResourceID: "lb-1"},
+			}},
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{ResourceID: "lb-2"},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.resourceId"},
+		},
+		{
+			name: "load balancer ID removed",
+			old: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{ResourceID: "lb-1"},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.resourceId"},
+		},
+		{
+			name: "public load balancer ID",
+			old: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers:      v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{ResourceID: "lb-1"},
+			}},
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers:      v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+				APIServerPublicLoadbalancer: &v1beta2.DOLoadBalancer{ResourceID: "lb-2"},
+			}},
+			wantErrs: []string{"spec.network.apiServerPublicLoadbalancer.resourceId"},
+		},
+		{
+			name: "load balancer network defaulted",
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkExternal},
+			}},
+		},
+		{
+			name: "load balancer network",
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{Network: v1beta2.LBNetworkInternal},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.network"},
+		},
+		{
+			name:     "VPC",
+			old:      v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{VPC: v1beta2.DOVPC{Name: "capdo"}}},
+			new:      v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{VPC: v1beta2.DOVPC{Name: "other"}}},
+			wantErrs: []string{"spec.network.vpc"},
+		},
+		{
+			name: "IP family defaulted",
+			new:  v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{IPFamily: v1beta2.DOIPFamilyIPv4}},
+		},
+		{
+			name:     "IP family",
+			new:      v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{IPFamily: v1beta2.DOIPFamilyDualStack}},
+			wantErrs: []string{"spec.network.ipFamily"},
+		},
+		{
+			name: "bastion added",
+			new:  v1beta2.DOClusterSpec{Bastion: &testBastion},
+		},
+		{
+			name: "bastion allowed CIDRs",
+			old:  v1beta2.DOClusterSpec{Bastion: &testBastion},
+			new:  v1beta2.DOClusterSpec{Bastion: &restrictedBastion},
+		},
+		{
+			name:     "bastion droplet",
+			old:      v1beta2.DOClusterSpec{Bastion: &testBastion},
+			new:      v1beta2.DOClusterSpec{Bastion: &otherBastion},
+			wantErrs: []string{"spec.bastion.size", "spec.bastion.image", "spec.bastion.sshKeys"},
+		},
+		{
+			name: "control plane DNS before the endpoint is set",
+			old:  v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS},
+			new:  v1beta2.DOClusterSpec{ControlPlaneDNS: k8sDNS},
+		},
+		{
+			name:         "control plane DNS after the endpoint is set",
+			old:          v1beta2.DOClusterSpec{ControlPlaneDNS: apiDNS, ControlPlaneEndpoint: endpoint},
+			new:          v1beta2.DOClusterSpec{ControlPlaneDNS: k8sDNS, ControlPlaneEndpoint: endpoint},
+			wantWarnings: 1,
+		},
+		{
+			name: "spec is validated",
+			new: v1beta2.DOClusterSpec{Network: v1beta2.DONetwork{
+				APIServerLoadbalancers: v1beta2.DOLoadBalancer{HTTPIdleTimeoutSeconds: 60},
+			}},
+			wantErrs: []string{"spec.network.apiServerLoadbalancers.httpIdleTimeoutSeconds"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &DOClusterWebhook{}
+			warnings, err := w.ValidateUpdate(context.TODO(), &v1beta2.DOCluster{Spec: tt.old}, &v1beta2.DOCluster{Spec: tt.new})
+			if (err != nil) != (len(tt.wantErrs) > 0) {
+				t.Fatalf("ValidateUpdate() error = %v, want errors on %v", err, tt.wantErrs)
+			}
+			if got := errorFields(err); !reflect.DeepEqual(got, tt.wantErrs) {
+				t.Errorf("ValidateUpdate() errors on %v, want %v", got, tt.wantErrs)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("ValidateUpdate() warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestDOClusterWebhook_WrongType(t *testing.T) {
+	w := &DOClusterWebhook{}
+	if _, err := w.ValidateCreate(context.TODO(), &v1beta2.DOMachine{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("ValidateCreate() error = %v, want a bad request", err)
+	}
+	if _, err := w.ValidateUpdate(context.TODO(), &v1beta2.DOMachine{}, &v1beta2.DOCluster{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("ValidateUpdate() error = %v, want a bad request", err)
+	}
+	if _, err := w.ValidateUpdate(context.TODO(), &v1beta2.DOCluster{}, &v1beta2.DOMachine{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("ValidateUpdate() error = %v, want a bad request", err)
+	}
+}
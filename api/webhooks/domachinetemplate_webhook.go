@@ -24,7 +24,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -34,8 +34,8 @@ import (
 // log is for logging in this package.
 var _ = logf.Log.WithName("domachinetemplate-resource")
 
-// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-domachinetemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=domachinetemplates,versions=v1beta1,name=validation.domachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
-// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-domachinetemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=domachinetemplates,versions=v1beta1,name=default.domachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-domachinetemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=domachinetemplates,versions=v1beta2,name=validation.domachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-domachinetemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=domachinetemplates,versions=v1beta2,name=default.domachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
 
 // DOMachineTemplateWebhook is a collection of webhooks for DOMachineTemplate objects.
 type DOMachineTemplateWebhook struct{}
@@ -47,7 +47,7 @@ var (
 
 func (w *DOMachineTemplateWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
-		For(&v1beta1.DOMachineTemplate{}).
+		For(&v1beta2.DOMachineTemplate{}).
 		WithDefaulter(&DOMachineTemplateWebhook{}).
 		WithValidator(&DOMachineTemplateWebhook{}).
 		Complete()
@@ -62,7 +62,7 @@ func (w *DOMachineTemplateWebhook) Default(context.Context, runtime.Object) erro
 func (w *DOMachineTemplateWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 
-	doMachineTemplate, ok := obj.(*v1beta1.DOMachineTemplate)
+	doMachineTemplate, ok := obj.(*v1beta2.DOMachineTemplate)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an DOMachineTemplate object but got a %T", obj))
 	}
@@ -82,12 +82,12 @@ func (w *DOMachineTemplateWebhook) ValidateCreate(_ context.Context, obj runtime
 func (w *DOMachineTemplateWebhook) ValidateUpdate(_ context.Context, objOld, objNew runtime.Object) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 
-	oldDOMachineTemplate, ok := objOld.(*v1beta1.DOMachineTemplate)
+	oldDOMachineTemplate, ok := objOld.(*v1beta2.DOMachineTemplate)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an DOMachineTemplate old object but got a %T", objOld))
 	}
 
-	newDOMachineTemplate, ok := objNew.(*v1beta1.DOMachineTemplate)
+	newDOMachineTemplate, ok := objNew.(*v1beta2.DOMachineTemplate)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an DOMachineTemplate new object but got a %T", objNew))
 	}
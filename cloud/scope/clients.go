@@ -24,11 +24,19 @@ import (
 
 // DOClients hold all necessary clients to work with the DO API.
 type DOClients struct {
-	Actions       godo.ActionsService
-	Droplets      computesenhanced.DropletsService
-	Storage       godo.StorageService
-	Images        godo.ImagesService
-	Keys          godo.KeysService
-	LoadBalancers godo.LoadBalancersService
-	Domains       godo.DomainsService
+	Actions        godo.ActionsService
+	Droplets       computesenhanced.DropletsService
+	DropletActions godo.DropletActionsService
+	Storage        godo.StorageService
+	Images         godo.ImagesService
+	Keys           godo.KeysService
+	LoadBalancers  godo.LoadBalancersService
+	Domains        godo.DomainsService
+	VPCs           godo.VPCsService
+	Firewalls      godo.FirewallsService
+	Tags           godo.TagsService
+	Projects       godo.ProjectsService
+
+	ReservedIPs       godo.ReservedIPsService
+	ReservedIPActions godo.ReservedIPActionsService
 }
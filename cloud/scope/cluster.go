@@ -22,13 +22,16 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2/klogr"
-	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"k8s.io/utils/ptr"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
-	"sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computesenhanced"
 )
 
@@ -54,7 +57,7 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.Logger = klogr.New() //nolint:staticcheck
 	}
 
-	session, err := params.Session()
+	session, err := clusterSession(context.TODO(), params)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create DO session")
 	}
@@ -67,6 +70,10 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.Droplets = computesenhanced.NewDropletService(session, session.Droplets)
 	}
 
+	if params.DropletActions == nil {
+		params.DropletActions = session.DropletActions
+	}
+
 	if params.Storage == nil {
 		params.Storage = session.Storage
 	}
@@ -87,6 +94,30 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.Domains = session.Domains
 	}
 
+	if params.VPCs == nil {
+		params.VPCs = session.VPCs
+	}
+
+	if params.Firewalls == nil {
+		params.Firewalls = session.Firewalls
+	}
+
+	if params.Tags == nil {
+		params.Tags = session.Tags
+	}
+
+	if params.Projects == nil {
+		params.Projects = session.Projects
+	}
+
+	if params.ReservedIPs == nil {
+		params.ReservedIPs = session.ReservedIPs
+	}
+
+	if params.ReservedIPActions == nil {
+		params.ReservedIPActions = session.ReservedIPActions
+	}
+
 	helper, err := patch.NewHelper(params.DOCluster, params.Client)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init patch helper")
@@ -115,7 +146,34 @@ type ClusterScope struct {
 
 // Close closes the current scope persisting the cluster configuration and status.
 func (s *ClusterScope) Close() error {
-	return s.patchHelper.Patch(context.TODO(), s.DOCluster)
+	if err := conditions.SetSummaryCondition(s.DOCluster, s.DOCluster, infrav1.ReadyCondition,
+		conditions.ForConditionTypes{
+			infrav1.VPCReadyCondition,
+			infrav1.LoadBalancerReadyCondition,
+			infrav1.DNSRecordReadyCondition,
+		},
+		// The API server is not always exposed through load balancers, and
+		// DNS records are optional.
+		conditions.IgnoreTypesIfMissing{
+			infrav1.LoadBalancerReadyCondition,
+			infrav1.DNSRecordReadyCondition,
+		},
+	); err != nil {
+		return errors.Wrap(err, "failed to set the DOCluster Ready condition")
+	}
+
+	return s.PatchObject()
+}
+
+// PatchObject persists the cluster configuration and status.
+func (s *ClusterScope) PatchObject() error {
+	return s.patchHelper.Patch(context.TODO(), s.DOCluster, patch.WithOwnedConditions{Conditions: []string{
+		infrav1.ReadyCondition,
+		infrav1.VPCReadyCondition,
+		infrav1.LoadBalancerReadyCondition,
+		infrav1.DNSRecordReadyCondition,
+		infrav1.ControlPlaneDNSPropagatedCondition,
+	}})
 }
 
 // Name returns the cluster name.
@@ -143,9 +201,9 @@ func (s *ClusterScope) Network() *infrav1.DONetworkResource {
 	return &s.DOCluster.Status.Network
 }
 
-// SetReady sets the DOCluster Ready Status.
+// SetReady marks the DOCluster infrastructure as provisioned.
 func (s *ClusterScope) SetReady() {
-	s.DOCluster.Status.Ready = true
+	s.DOCluster.Status.Initialization = &infrav1.DOClusterInitializationStatus{Provisioned: ptr.To(true)}
 }
 
 // SetControlPlaneDNSRecordReady sets the DOCluster ControlPlaneDNSRecordReady Status.
@@ -153,8 +211,23 @@ func (s *ClusterScope) SetControlPlaneDNSRecordReady(ready bool) {
 	s.DOCluster.Status.ControlPlaneDNSRecordReady = ready
 }
 
+// ControlPlaneDNSStatus gets the DOCluster status ControlPlaneDNS.
+func (s *ClusterScope) ControlPlaneDNSStatus() *infrav1.DOControlPlaneDNS {
+	return s.DOCluster.Status.ControlPlaneDNS
+}
+
+// SetControlPlaneDNSStatus sets the DOCluster status ControlPlaneDNS.
+func (s *ClusterScope) SetControlPlaneDNSStatus(record *infrav1.DOControlPlaneDNS) {
+	s.DOCluster.Status.ControlPlaneDNS = record
+}
+
+// SetDNSRecords sets the DOCluster status additional DNS records.
+func (s *ClusterScope) SetDNSRecords(records []infrav1.DODNSRecordStatus) {
+	s.DOCluster.Status.DNSRecords = records
+}
+
 // SetControlPlaneEndpoint sets the DOCluster status APIEndpoints.
-func (s *ClusterScope) SetControlPlaneEndpoint(apiEndpoint clusterv1beta1.APIEndpoint) {
+func (s *ClusterScope) SetControlPlaneEndpoint(apiEndpoint clusterv1beta2.APIEndpoint) {
 	s.DOCluster.Spec.ControlPlaneEndpoint = apiEndpoint
 }
 
@@ -172,3 +245,161 @@ func (s *ClusterScope) APIServerLoadbalancersRef() *infrav1.DOResourceReference
 func (s *ClusterScope) VPC() *infrav1.DOVPC {
 	return &s.DOCluster.Spec.Network.VPC
 }
+
+// VPCRef gets the DOCluster status Network VPC.
+func (s *ClusterScope) VPCRef() *infrav1.DOVPCResource {
+	return &s.DOCluster.Status.Network.VPC
+}
+
+// VPCUUID returns the UUID of the VPC cluster resources are placed in. It is
+// either the user supplied VPC or the one created for the cluster. An empty
+// value implies using the default VPC.
+func (s *ClusterScope) VPCUUID() string {
+	if s.VPC().VPCUUID != "" {
+		return s.VPC().VPCUUID
+	}
+	return s.VPCRef().ResourceID
+}
+
+// APIServerEndpointType gets the DOCluster Spec Network APIServerEndpoint type,
+// defaulting to LoadBalancer.
+func (s *ClusterScope) APIServerEndpointType() infrav1.DOAPIServerEndpointType {
+	if t := s.DOCluster.Spec.Network.APIServerEndpoint.Type; t != "" {
+		return t
+	}
+	return infrav1.DOAPIServerEndpointTypeLoadBalancer
+}
+
+// APIServerPublicLoadbalancer gets the DOCluster Spec Network public API server loadbalancer.
+func (s *ClusterScope) APIServerPublicLoadbalancer() *infrav1.DOLoadBalancer {
+	return s.DOCluster.Spec.Network.APIServerPublicLoadbalancer
+}
+
+// APIServerPublicLoadbalancerRef gets the DOCluster status Network public API server loadbalancer reference.
+func (s *ClusterScope) APIServerPublicLoadbalancerRef() *infrav1.DOResourceReference {
+	return &s.DOCluster.Status.Network.APIServerPublicLoadbalancerRef
+}
+
+// APIServerPrimaryEndpoint gets the visibility of the endpoint selected as
+// control plane endpoint, defaulting to Private.
+func (s *ClusterScope) APIServerPrimaryEndpoint() infrav1.DOEndpointVisibility {
+	if p := s.DOCluster.Spec.Network.APIServerEndpoint.Primary; p != "" {
+		return p
+	}
+	return infrav1.DOEndpointVisibilityPrivate
+}
+
+// SetAPIServerEndpoints sets the DOCluster status Network API server endpoints.
+func (s *ClusterScope) SetAPIServerEndpoints(endpoints []infrav1.DOAPIServerEndpointStatus) {
+	s.DOCluster.Status.Network.APIServerEndpoints = endpoints
+}
+
+// APIServerReservedIP gets the DOCluster status Network API server reserved IP.
+func (s *ClusterScope) APIServerReservedIP() *infrav1.DOReservedIPResource {
+	return &s.DOCluster.Status.Network.APIServerReservedIP
+}
+
+// IPFamily gets the DOCluster Spec Network IP family, defaulting to IPv4.
+func (s *ClusterScope) IPFamily() infrav1.DOIPFamily {
+	if f := s.DOCluster.Spec.Network.IPFamily; f != "" {
+		return f
+	}
+	return infrav1.DOIPFamilyIPv4
+}
+
+// DualStack returns true if the cluster is dual-stack.
+func (s *ClusterScope) DualStack() bool {
+	return s.IPFamily() == infrav1.DOIPFamilyDualStack
+}
+
+// Firewall gets the DOCluster Spec Network Firewall.
+func (s *ClusterScope) Firewall() *infrav1.DOFirewall {
+	return s.DOCluster.Spec.Network.Firewall
+}
+
+// FirewallRef gets the DOCluster status Network firewall reference for the given role.
+func (s *ClusterScope) FirewallRef(role string) *infrav1.DOResourceReference {
+	switch role {
+	case infrav1.APIServerRoleTagValue:
+		return &s.DOCluster.Status.Network.APIServerFirewallRef
+	case infrav1.BastionRoleTagValue:
+		return &s.DOCluster.Status.Network.BastionFirewallRef
+	default:
+		return &s.DOCluster.Status.Network.NodeFirewallRef
+	}
+}
+
+// Bastion gets the DOCluster Spec Bastion.
+func (s *ClusterScope) Bastion() *infrav1.DOBastion {
+	return s.DOCluster.Spec.Bastion
+}
+
+// BastionStatus gets the DOCluster status Bastion, initializing it if needed.
+func (s *ClusterScope) BastionStatus() *infrav1.DOBastionStatus {
+	if s.DOCluster.Status.Bastion == nil {
+		s.DOCluster.Status.Bastion = &infrav1.DOBastionStatus{}
+	}
+	return s.DOCluster.Status.Bastion
+}
+
+// ClearBastionStatus removes the DOCluster status Bastion.
+func (s *ClusterScope) ClearBastionStatus() {
+	s.DOCluster.Status.Bastion = nil
+}
+
+// AdditionalTags returns AdditionalTags from the scope's DOCluster. The returned value will never be nil.
+func (s *ClusterScope) AdditionalTags() infrav1.Tags {
+	if s.DOCluster.Spec.AdditionalTags == nil {
+		return infrav1.Tags{}
+	}
+
+	return s.DOCluster.Spec.AdditionalTags.DeepCopy()
+}
+
+// AppliedTags returns the additional tags last applied to the DOCluster resources.
+func (s *ClusterScope) AppliedTags() infrav1.Tags {
+	return s.DOCluster.Status.AppliedTags
+}
+
+// SetAppliedTags sets the additional tags last applied to the DOCluster resources.
+func (s *ClusterScope) SetAppliedTags(v infrav1.Tags) {
+	s.DOCluster.Status.AppliedTags = v
+}
+
+// Project gets the DOCluster Spec Project.
+func (s *ClusterScope) Project() *infrav1.DOProject {
+	return s.DOCluster.Spec.Project
+}
+
+// ProjectID returns the ID of the project the cluster resources are assigned to.
+func (s *ClusterScope) ProjectID() string {
+	return s.DOCluster.Status.ProjectID
+}
+
+// SetProjectID sets the ID of the project the cluster resources are assigned to.
+func (s *ClusterScope) SetProjectID(id string) {
+	s.DOCluster.Status.ProjectID = id
+}
+
+// DNSProvider gets the DOCluster Spec DNSProvider, defaulting to the
+// DigitalOcean Domains API.
+func (s *ClusterScope) DNSProvider() infrav1.DODNSProvider {
+	if s.DOCluster.Spec.DNSProvider == nil {
+		return infrav1.DODNSProvider{Type: infrav1.DODNSProviderDigitalOcean}
+	}
+	provider := *s.DOCluster.Spec.DNSProvider
+	if provider.Type == "" {
+		provider.Type = infrav1.DODNSProviderDigitalOcean
+	}
+	return provider
+}
+
+// GetSecretData returns the data of the given Secret in the DOCluster namespace.
+func (s *ClusterScope) GetSecretData(ctx context.Context, name string) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: s.DOCluster.Namespace, Name: name}
+	if err := s.client.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve secret %s for DOCluster %s/%s", name, s.DOCluster.Namespace, s.DOCluster.Name)
+	}
+	return secret.Data, nil
+}
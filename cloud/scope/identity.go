@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"slices"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// clusterSession returns the DO session of the cluster, authenticated with
+// the DOClusterIdentity referenced by the DOCluster if any, and with the
+// DIGITALOCEAN_ACCESS_TOKEN env var otherwise.
+func clusterSession(ctx context.Context, params ClusterScopeParams) (*godo.Client, error) {
+	ref := params.DOCluster.Spec.IdentityRef
+	if ref == nil {
+		return params.Session()
+	}
+	if params.Client == nil {
+		return nil, errors.New("Client is required when the DOCluster references a DOClusterIdentity")
+	}
+
+	identity := &infrav1.DOClusterIdentity{}
+	if err := params.Client.Get(ctx, types.NamespacedName{Name: ref.Name}, identity); err != nil {
+		return nil, errors.Wrapf(err, "failed to get DOClusterIdentity %s", ref.Name)
+	}
+
+	allowed, err := identityAllowsNamespace(ctx, params.Client, identity, params.DOCluster.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.Errorf("DOClusterIdentity %s is not allowed to be used from namespace %s", identity.Name, params.DOCluster.Namespace)
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: identity.Spec.SecretRef.Namespace, Name: identity.Spec.SecretRef.Name}
+	if err := params.Client.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret %s of DOClusterIdentity %s", key, identity.Name)
+	}
+	accessToken := string(secret.Data[infrav1.DOClusterIdentityTokenKey])
+	if accessToken == "" {
+		return nil, errors.Errorf("secret %s of DOClusterIdentity %s has no %q key", key, identity.Name, infrav1.DOClusterIdentityTokenKey)
+	}
+
+	return params.SessionWithToken(accessToken)
+}
+
+// identityAllowsNamespace returns true if the DOClusters of the namespace are
+// allowed to use the identity.
+func identityAllowsNamespace(ctx context.Context, c client.Client, identity *infrav1.DOClusterIdentity, namespace string) (bool, error) {
+	allowed := identity.Spec.AllowedNamespaces
+	switch {
+	case allowed == nil:
+		return false, nil
+	case allowed.NamespaceList == nil && allowed.Selector == nil:
+		return true, nil
+	case slices.Contains(allowed.NamespaceList, namespace):
+		return true, nil
+	case allowed.Selector == nil:
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(allowed.Selector)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid namespace selector of DOClusterIdentity %s", identity.Name)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, errors.Wrapf(err, "failed to get namespace %s", namespace)
+	}
+
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
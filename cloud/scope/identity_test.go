@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+func TestClusterSession(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(infrav1.AddToScheme(scheme))
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "do-credentials", Namespace: "capdo-system"},
+		Data:       map[string][]byte{infrav1.DOClusterIdentityTokenKey: []byte("super-secret-token")},
+	}
+	emptySecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "capdo-system"}}
+	newIdentity := func(secretName string, allowed *infrav1.DOClusterIdentityAllowedNamespaces) *infrav1.DOClusterIdentity {
+		return &infrav1.DOClusterIdentity{
+			ObjectMeta: metav1.ObjectMeta{Name: "team"},
+			Spec: infrav1.DOClusterIdentitySpec{
+				SecretRef:         corev1.SecretReference{Name: secretName, Namespace: "capdo-system"},
+				AllowedNamespaces: allowed,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		identityRef *infrav1.DOClusterIdentityReference
+		identity    *infrav1.DOClusterIdentity
+		envToken    string
+		wantErr     bool
+	}{
+		{
+			name:     "env var is used without identity",
+			envToken: "env-token",
+		},
+		{
+			name:    "env var is required without identity",
+			wantErr: true,
+		},
+		{
+			name:        "identity allowing all namespaces",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			identity:    newIdentity("do-credentials", &infrav1.DOClusterIdentityAllowedNamespaces{}),
+		},
+		{
+			name:        "identity allowing the namespace by name",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			identity:    newIdentity("do-credentials", &infrav1.DOClusterIdentityAllowedNamespaces{NamespaceList: []string{"team-a"}}),
+		},
+		{
+			name:        "identity allowing the namespace by labels",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			identity: newIdentity("do-credentials", &infrav1.DOClusterIdentityAllowedNamespaces{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			}),
+		},
+		{
+			name:        "identity allowing other namespaces",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			identity: newIdentity("do-credentials", &infrav1.DOClusterIdentityAllowedNamespaces{
+				NamespaceList: []string{"team-b"},
+				Selector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			}),
+			wantErr: true,
+		},
+		{
+			name:        "identity without allowed namespaces",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			identity:    newIdentity("do-credentials", nil),
+			wantErr:     true,
+		},
+		{
+			name:        "identity secret without token",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			identity:    newIdentity("empty", &infrav1.DOClusterIdentityAllowedNamespaces{}),
+			wantErr:     true,
+		},
+		{
+			name:        "identity not found",
+			identityRef: &infrav1.DOClusterIdentityReference{Name: "team"},
+			envToken:    "env-token",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DIGITALOCEAN_ACCESS_TOKEN", tt.envToken)
+
+			objs := []client.Object{namespace, secret, emptySecret}
+			if tt.identity != nil {
+				objs = append(objs, tt.identity)
+			}
+			params := ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				DOCluster: &infrav1.DOCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", Namespace: "team-a"},
+					Spec:       infrav1.DOClusterSpec{IdentityRef: tt.identityRef},
+				},
+			}
+
+			session, err := clusterSession(context.TODO(), params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("clusterSession() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && session == nil {
+				t.Errorf("clusterSession() returned no session")
+			}
+		})
+	}
+}
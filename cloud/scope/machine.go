@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -34,10 +35,11 @@ import (
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	capierrors "sigs.k8s.io/cluster-api/errors" //nolint:staticcheck
 	"sigs.k8s.io/cluster-api/util"
-	"sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 )
 
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
@@ -107,7 +109,22 @@ type MachineScope struct {
 
 // Close the MachineScope by updating the machine spec, machine status.
 func (m *MachineScope) Close() error {
-	return m.patchHelper.Patch(context.TODO(), m.DOMachine)
+	if err := conditions.SetSummaryCondition(m.DOMachine, m.DOMachine, infrav1.ReadyCondition,
+		conditions.ForConditionTypes{
+			infrav1.BootstrapDataReadyCondition,
+			infrav1.VolumesReadyCondition,
+			infrav1.InstanceReadyCondition,
+		},
+	); err != nil {
+		return errors.Wrap(err, "failed to set the DOMachine Ready condition")
+	}
+
+	return m.patchHelper.Patch(context.TODO(), m.DOMachine, patch.WithOwnedConditions{Conditions: []string{
+		infrav1.ReadyCondition,
+		infrav1.BootstrapDataReadyCondition,
+		infrav1.VolumesReadyCondition,
+		infrav1.InstanceReadyCondition,
+	}})
 }
 
 // Name returns the DOMachine name.
@@ -158,8 +175,11 @@ func (m *MachineScope) SetVolumes(volumeIDs []string) {
 
 // GetInstanceID returns the DOMachine droplet instance id by parsing Spec.ProviderID.
 func (m *MachineScope) GetInstanceID() string {
-	id := m.GetProviderID()
+	return instanceIDFromProviderID(m.GetProviderID())
+}
 
+// instanceIDFromProviderID returns the droplet instance id of a providerID.
+func instanceIDFromProviderID(id string) string {
 	if id == "" ||
 		!regexp.MustCompile("^[^:]+://.*[^/]$").MatchString(id) {
 		return ""
@@ -188,24 +208,46 @@ func (m *MachineScope) SetInstanceStatus(v infrav1.DOResourceStatus) {
 	m.DOMachine.Status.InstanceStatus = &v
 }
 
-// IsReady returns the DOMachine Ready Status.
+// PowerOnStatus returns the attempts to power the DOMachine droplet back on.
+func (m *MachineScope) PowerOnStatus() *infrav1.DOPowerOnStatus {
+	return m.DOMachine.Status.PowerOn
+}
+
+// SetPowerOnStatus sets the attempts to power the DOMachine droplet back on.
+func (m *MachineScope) SetPowerOnStatus(v *infrav1.DOPowerOnStatus) {
+	m.DOMachine.Status.PowerOn = v
+}
+
+// IsReady returns whether the DOMachine is provisioned.
 func (m *MachineScope) IsReady() bool {
-	return m.DOMachine.Status.Ready
+	return m.DOMachine.Status.Initialization != nil && ptr.Deref(m.DOMachine.Status.Initialization.Provisioned, false)
 }
 
-// SetReady sets the DOMachine Ready Status.
+// SetReady marks the DOMachine as provisioned.
 func (m *MachineScope) SetReady() {
-	m.DOMachine.Status.Ready = true
+	m.DOMachine.Status.Initialization = &infrav1.DOMachineInitializationStatus{Provisioned: ptr.To(true)}
 }
 
 // SetFailureMessage sets the DOMachine status error message.
 func (m *MachineScope) SetFailureMessage(v error) {
-	m.DOMachine.Status.FailureMessage = ptr.To[string](v.Error())
+	m.v1beta1DeprecatedStatus().FailureMessage = ptr.To[string](v.Error())
 }
 
 // SetFailureReason sets the DOMachine status error reason.
 func (m *MachineScope) SetFailureReason(v capierrors.MachineStatusError) {
-	m.DOMachine.Status.FailureReason = &v
+	m.v1beta1DeprecatedStatus().FailureReason = &v
+}
+
+// v1beta1DeprecatedStatus returns the DOMachine status fields kept for
+// v1beta1 clients, initializing them if needed.
+func (m *MachineScope) v1beta1DeprecatedStatus() *infrav1.DOMachineV1Beta1DeprecatedStatus {
+	if m.DOMachine.Status.Deprecated == nil {
+		m.DOMachine.Status.Deprecated = &infrav1.DOMachineDeprecatedStatus{}
+	}
+	if m.DOMachine.Status.Deprecated.V1Beta1 == nil {
+		m.DOMachine.Status.Deprecated.V1Beta1 = &infrav1.DOMachineV1Beta1DeprecatedStatus{}
+	}
+	return m.DOMachine.Status.Deprecated.V1Beta1
 }
 
 // SetAddresses sets the address status.
@@ -213,13 +255,44 @@ func (m *MachineScope) SetAddresses(addrs []corev1.NodeAddress) {
 	m.DOMachine.Status.Addresses = addrs
 }
 
-// AdditionalTags returns AdditionalTags from the scope's DOMachine. The returned value will never be nil.
+// IPFamily returns the IP family of the DOMachine, defaulting to the one of
+// the DOCluster and then to IPv4.
+func (m *MachineScope) IPFamily() infrav1.DOIPFamily {
+	if f := m.DOMachine.Spec.IPFamily; f != "" {
+		return f
+	}
+	if f := m.DOCluster.Spec.Network.IPFamily; f != "" {
+		return f
+	}
+	return infrav1.DOIPFamilyIPv4
+}
+
+// DualStack returns true if the droplet of the DOMachine is dual-stack.
+func (m *MachineScope) DualStack() bool {
+	return m.IPFamily() == infrav1.DOIPFamilyDualStack
+}
+
+// AdditionalTags returns the AdditionalTags of the scope's DOCluster followed by
+// the ones of the DOMachine. The returned value will never be nil.
 func (m *MachineScope) AdditionalTags() infrav1.Tags {
-	if m.DOMachine.Spec.AdditionalTags == nil {
-		m.DOMachine.Spec.AdditionalTags = infrav1.Tags{}
+	tags := infrav1.Tags{}
+	for _, tag := range append(m.DOCluster.Spec.AdditionalTags.DeepCopy(), m.DOMachine.Spec.AdditionalTags...) {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
 	}
 
-	return m.DOMachine.Spec.AdditionalTags.DeepCopy()
+	return tags
+}
+
+// AppliedTags returns the additional tags last applied to the DOMachine resources.
+func (m *MachineScope) AppliedTags() infrav1.Tags {
+	return m.DOMachine.Status.AppliedTags
+}
+
+// SetAppliedTags sets the additional tags last applied to the DOMachine resources.
+func (m *MachineScope) SetAppliedTags(v infrav1.Tags) {
+	m.DOMachine.Status.AppliedTags = v
 }
 
 // GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+const (
+	// DefaultPowerCycleTimeout is the default time given to a machine to
+	// become healthy after its droplet was power cycled.
+	DefaultPowerCycleTimeout = 5 * time.Minute
+	// DefaultRebuildTimeout is the default time given to a machine to become
+	// healthy after its droplet was rebuilt.
+	DefaultRebuildTimeout = 20 * time.Minute
+)
+
+// RemediationScopeParams defines the input parameters used to create a new RemediationScope.
+type RemediationScopeParams struct {
+	Client        client.Client
+	Logger        logr.Logger
+	Machine       *clusterv1beta2.Machine
+	DOMachine     *infrav1.DOMachine
+	DORemediation *infrav1.DORemediation
+}
+
+// NewRemediationScope creates a new RemediationScope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+func NewRemediationScope(params RemediationScopeParams) (*RemediationScope, error) {
+	if params.Client == nil {
+		return nil, errors.New("Client is required when creating a RemediationScope")
+	}
+	if params.Machine == nil {
+		return nil, errors.New("Machine is required when creating a RemediationScope")
+	}
+	if params.DOMachine == nil {
+		return nil, errors.New("DOMachine is required when creating a RemediationScope")
+	}
+	if params.DORemediation == nil {
+		return nil, errors.New("DORemediation is required when creating a RemediationScope")
+	}
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klogr.New() //nolint:staticcheck
+	}
+
+	helper, err := patch.NewHelper(params.DORemediation, params.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init patch helper")
+	}
+	return &RemediationScope{
+		Logger:        params.Logger,
+		client:        params.Client,
+		patchHelper:   helper,
+		Machine:       params.Machine,
+		DOMachine:     params.DOMachine,
+		DORemediation: params.DORemediation,
+	}, nil
+}
+
+// RemediationScope defines a scope defined around the remediation of a machine.
+type RemediationScope struct {
+	logr.Logger
+	client      client.Client
+	patchHelper *patch.Helper
+
+	Machine       *clusterv1beta2.Machine
+	DOMachine     *infrav1.DOMachine
+	DORemediation *infrav1.DORemediation
+}
+
+// Close the RemediationScope by updating the DORemediation status.
+func (s *RemediationScope) Close() error {
+	return s.patchHelper.Patch(context.TODO(), s.DORemediation)
+}
+
+// GetInstanceID returns the droplet instance id of the remediated machine.
+func (s *RemediationScope) GetInstanceID() string {
+	if s.DOMachine.Spec.ProviderID == nil {
+		return ""
+	}
+	return instanceIDFromProviderID(*s.DOMachine.Spec.ProviderID)
+}
+
+// Phase returns the remediation step in progress.
+func (s *RemediationScope) Phase() infrav1.DORemediationPhase {
+	return s.DORemediation.Status.Phase
+}
+
+// SetPhase starts the given remediation step.
+func (s *RemediationScope) SetPhase(phase infrav1.DORemediationPhase) {
+	now := metav1.Now()
+	s.DORemediation.Status.Phase = phase
+	s.DORemediation.Status.LastStepTime = &now
+}
+
+// StepTimeLeft returns the time left before the remediation step in progress
+// times out.
+func (s *RemediationScope) StepTimeLeft() time.Duration {
+	var timeout time.Duration
+	switch s.Phase() {
+	case infrav1.DORemediationPhasePowerCycling:
+		timeout = DefaultPowerCycleTimeout
+		if t := s.DORemediation.Spec.PowerCycleTimeout; t != nil {
+			timeout = t.Duration
+		}
+	case infrav1.DORemediationPhaseRebuilding:
+		timeout = DefaultRebuildTimeout
+		if t := s.DORemediation.Spec.RebuildTimeout; t != nil {
+			timeout = t.Duration
+		}
+	}
+	if s.DORemediation.Status.LastStepTime == nil {
+		return 0
+	}
+	return timeout - time.Since(s.DORemediation.Status.LastStepTime.Time)
+}
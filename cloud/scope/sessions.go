@@ -39,13 +39,18 @@ func (t *TokenSource) Token() (*oauth2.Token, error) {
 	return token, nil
 }
 
-// Session return the DO session.
+// Session return the DO session authenticated with the DIGITALOCEAN_ACCESS_TOKEN env var.
 func (c *DOClients) Session() (*godo.Client, error) {
 	accessToken := os.Getenv("DIGITALOCEAN_ACCESS_TOKEN")
 	if accessToken == "" {
 		return nil, errors.New("env var DIGITALOCEAN_ACCESS_TOKEN is required")
 	}
 
+	return c.SessionWithToken(accessToken)
+}
+
+// SessionWithToken return the DO session authenticated with the given access token.
+func (c *DOClients) SessionWithToken(accessToken string) (*godo.Client, error) {
 	oc := oauth2.NewClient(context.Background(), &TokenSource{
 		AccessToken: accessToken,
 	})
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computes
+
+import (
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// BastionName returns the name of the bastion droplet of the cluster.
+func (s *Service) BastionName() string {
+	return infrav1.DOSafeName(s.scope.Name()) + "-" + infrav1.BastionRoleTagValue
+}
+
+// GetBastion get the bastion droplet by ID, or by its role tag if the ID is unknown.
+func (s *Service) GetBastion(id string) (*godo.Droplet, error) {
+	if id != "" {
+		return s.GetDroplet(id)
+	}
+
+	tag := infrav1.ClusterNameUIDRoleTag(infrav1.DOSafeName(s.scope.Name()), s.scope.UID(), infrav1.BastionRoleTagValue)
+	droplets, _, err := s.scope.Droplets.ListByTag(s.ctx, tag, &godo.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list bastion droplets")
+	}
+
+	switch len(droplets) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &droplets[0], nil
+	default:
+		return nil, errors.Errorf("multiple bastion droplets (%d) found with tag %q", len(droplets), tag)
+	}
+}
+
+// CreateBastion create the bastion droplet.
+func (s *Service) CreateBastion(spec *infrav1.DOBastion) (*godo.Droplet, error) {
+	s.scope.V(2).Info("Creating the bastion instance")
+
+	clusterName := infrav1.DOSafeName(s.scope.Name())
+	instanceName := s.BastionName()
+
+	imageID, err := s.GetImageID(spec.Image)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting image")
+	}
+
+	sshkeys := []godo.DropletCreateSSHKey{}
+	for _, v := range spec.SSHKeys {
+		keys, err := s.GetSSHKey(v)
+		if err != nil {
+			return nil, err
+		}
+		sshkeys = append(sshkeys, godo.DropletCreateSSHKey{
+			ID:          keys.ID,
+			Fingerprint: keys.Fingerprint,
+		})
+	}
+
+	request := &godo.DropletCreateRequest{
+		Name:    instanceName,
+		Region:  s.scope.Region(),
+		Size:    spec.Size,
+		SSHKeys: sshkeys,
+		Image: godo.DropletCreateImage{
+			ID: imageID,
+		},
+		PrivateNetworking: true,
+		IPv6:              s.scope.DualStack(),
+		VPCUUID:           s.scope.VPCUUID(),
+	}
+
+	request.Tags = infrav1.BuildTags(infrav1.BuildTagParams{
+		ClusterName: clusterName,
+		ClusterUID:  s.scope.UID(),
+		Name:        instanceName,
+		Role:        infrav1.BastionRoleTagValue,
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	droplet, _, err := s.scope.Droplets.Create(s.ctx, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create bastion droplet")
+	}
+	return droplet, nil
+}
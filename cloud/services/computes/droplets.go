@@ -25,8 +25,9 @@ import (
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
 )
 
@@ -132,8 +133,9 @@ func (s *Service) CreateDroplet(scope *scope.MachineScope) (*godo.Droplet, error
 		},
 		UserData:          bootstrapData,
 		PrivateNetworking: true,
+		IPv6:              scope.DualStack(),
 		Volumes:           volumes,
-		VPCUUID:           s.scope.VPC().VPCUUID,
+		VPCUUID:           s.scope.VPCUUID(),
 	}
 
 	request.Tags = infrav1.BuildTags(infrav1.BuildTagParams{
@@ -181,6 +183,38 @@ func (s *Service) DeleteDroplet(id string) error {
 	return nil
 }
 
+// PowerOnDroplet requests a powered off droplet to be powered on.
+func (s *Service) PowerOnDroplet(id int) error {
+	s.scope.V(2).Info("Attempting to power on instance", "instance-id", id)
+	if _, _, err := s.scope.DropletActions.PowerOn(s.ctx, id); err != nil {
+		return errors.Wrapf(err, "failed to power on instance with id %d", id)
+	}
+	return nil
+}
+
+// PowerCycleDroplet requests a droplet to be powered off and back on.
+func (s *Service) PowerCycleDroplet(id int) error {
+	s.scope.V(2).Info("Attempting to power cycle instance", "instance-id", id)
+	if _, _, err := s.scope.DropletActions.PowerCycle(s.ctx, id); err != nil {
+		return errors.Wrapf(err, "failed to power cycle instance with id %d", id)
+	}
+	return nil
+}
+
+// RebuildDroplet requests a droplet to be rebuilt from the given image. The
+// droplet keeps its IP addresses and attached volumes.
+func (s *Service) RebuildDroplet(id int, image intstr.IntOrString) error {
+	s.scope.V(2).Info("Attempting to rebuild instance", "instance-id", id)
+	imageID, err := s.GetImageID(image)
+	if err != nil {
+		return errors.Wrap(err, "failed getting image")
+	}
+	if _, _, err := s.scope.DropletActions.RebuildByImageID(s.ctx, id, imageID); err != nil {
+		return errors.Wrapf(err, "failed to rebuild instance with id %d", id)
+	}
+	return nil
+}
+
 // GetDropletAddress convert droplet IPs to corev1.NodeAddresses.
 func (s *Service) GetDropletAddress(droplet *godo.Droplet) ([]corev1.NodeAddress, error) {
 	addresses := []corev1.NodeAddress{}
@@ -204,5 +238,28 @@ func (s *Service) GetDropletAddress(droplet *godo.Droplet) ([]corev1.NodeAddress
 		Address: publicv4,
 	})
 
+	publicv6, err := droplet.PublicIPv6()
+	if err != nil {
+		return addresses, err
+	}
+
+	if publicv6 != "" {
+		addresses = append(addresses, corev1.NodeAddress{
+			Type:    corev1.NodeExternalIP,
+			Address: publicv6,
+		})
+	}
+
 	return addresses, nil
 }
+
+// ListControlPlaneDroplets lists the control plane droplets of the cluster.
+func (s *Service) ListControlPlaneDroplets() ([]godo.Droplet, error) {
+	tag := infrav1.ClusterNameUIDRoleTag(infrav1.DOSafeName(s.scope.Name()), s.scope.UID(), infrav1.APIServerRoleTagValue)
+	droplets, _, err := s.scope.Droplets.ListByTag(s.ctx, tag, &godo.ListOptions{PerPage: 200})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list control plane droplets")
+	}
+
+	return droplets, nil
+}
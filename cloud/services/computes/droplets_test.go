@@ -38,7 +38,7 @@ import (
 
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes/mock_computes"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computesenhanced"
@@ -749,6 +749,47 @@ func TestService_GetDropletAddress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "dual-stack",
+			args: args{
+				&godo.Droplet{
+					ID:   1234,
+					Name: "capdo-test",
+					Networks: &godo.Networks{
+						V4: []godo.NetworkV4{
+							{
+								Type:      "private",
+								IPAddress: "10.0.0.1",
+							},
+							{
+								Type:      "public",
+								IPAddress: "192.168.1.1",
+							},
+						},
+						V6: []godo.NetworkV6{
+							{
+								Type:      "public",
+								IPAddress: "2001:db8::1",
+							},
+						},
+					},
+				},
+			},
+			want: []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "10.0.0.1",
+				},
+				{
+					Type:    corev1.NodeExternalIP,
+					Address: "192.168.1.1",
+				},
+				{
+					Type:    corev1.NodeExternalIP,
+					Address: "2001:db8::1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
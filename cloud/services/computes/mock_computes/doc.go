@@ -15,11 +15,15 @@ limitations under the License.
 */
 
 //go:generate ../../../../hack/tools/bin/mockgen -destination droplets_mock.go -package mock_computes github.com/digitalocean/godo DropletsService
+//go:generate ../../../../hack/tools/bin/mockgen -destination dropletactions_mock.go -package mock_computes github.com/digitalocean/godo DropletActionsService
 //go:generate ../../../../hack/tools/bin/mockgen -destination images_mock.go -package mock_computes github.com/digitalocean/godo ImagesService
 //go:generate ../../../../hack/tools/bin/mockgen -destination sshkeys_mock.go -package mock_computes github.com/digitalocean/godo KeysService
 //go:generate ../../../../hack/tools/bin/mockgen -destination volumes_mock.go -package mock_computes github.com/digitalocean/godo StorageService
+//go:generate ../../../../hack/tools/bin/mockgen -destination tags_mock.go -package mock_computes github.com/digitalocean/godo TagsService
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt droplets_mock.go > _droplets_mock.go && mv _droplets_mock.go droplets_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt dropletactions_mock.go > _dropletactions_mock.go && mv _dropletactions_mock.go dropletactions_mock.go"
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt images_mock.go > _images_mock.go && mv _images_mock.go images_mock.go"
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt sshkeys_mock.go > _sshkeys_mock.go && mv _sshkeys_mock.go sshkeys_mock.go"
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt volumes_mock.go > _volumes_mock.go && mv _volumes_mock.go volumes_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt tags_mock.go > _tags_mock.go && mv _tags_mock.go tags_mock.go"
 package mock_computes // nolint
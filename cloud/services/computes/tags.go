@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computes
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+)
+
+// ReconcileDropletTags makes sure the droplet and its data disk volumes carry
+// the additional tags of the machine. Tags previously applied by the provider
+// and since dropped from the spec are removed, all other tags are left alone.
+func (s *Service) ReconcileDropletTags(scope *scope.MachineScope, droplet *godo.Droplet) error {
+	desired := scope.AdditionalTags()
+	applied := scope.AppliedTags()
+
+	// The cluster, role and name tags are never removed, even when they were
+	// also listed as additional tags.
+	protected := infrav1.BuildTags(infrav1.BuildTagParams{
+		ClusterName: infrav1.DOSafeName(s.scope.Name()),
+		ClusterUID:  s.scope.UID(),
+		Name:        infrav1.DOSafeName(scope.Name()),
+		Role:        scope.Role(),
+	})
+
+	var toTag, toUntag []string
+	for _, tag := range desired {
+		if !slices.Contains(applied, tag) || !slices.Contains(droplet.Tags, tag) {
+			toTag = append(toTag, tag)
+		}
+	}
+	for _, tag := range applied {
+		if !slices.Contains(desired, tag) && !slices.Contains(protected, tag) {
+			toUntag = append(toUntag, tag)
+		}
+	}
+	if len(toTag) == 0 && len(toUntag) == 0 {
+		scope.SetAppliedTags(desired)
+		return nil
+	}
+
+	resources := []godo.Resource{{ID: strconv.Itoa(droplet.ID), Type: godo.DropletResourceType}}
+	for _, disk := range scope.DOMachine.Spec.DataDisks {
+		vol, err := s.GetVolumeByName(infrav1.DataDiskName(scope.DOMachine, disk.NameSuffix))
+		if err != nil {
+			return err
+		}
+		if vol != nil {
+			resources = append(resources, godo.Resource{ID: vol.ID, Type: godo.VolumeResourceType})
+		}
+	}
+
+	for _, tag := range toTag {
+		s.scope.V(2).Info("Tagging instance", "instance-id", droplet.ID, "tag", tag)
+		if err := s.tagResources(tag, resources); err != nil {
+			return errors.Wrapf(err, "failed to tag instance with id %d", droplet.ID)
+		}
+	}
+	for _, tag := range toUntag {
+		s.scope.V(2).Info("Untagging instance", "instance-id", droplet.ID, "tag", tag)
+		if err := s.untagResources(tag, resources); err != nil {
+			return errors.Wrapf(err, "failed to untag instance with id %d", droplet.ID)
+		}
+	}
+
+	scope.SetAppliedTags(desired)
+	return nil
+}
+
+// TaggedResource is a cluster resource carrying the additional tags of the
+// DOCluster.
+type TaggedResource struct {
+	godo.Resource
+	// Tags are the tags currently carried by the resource.
+	Tags []string
+	// BaseTags are the tags the resource was created with. They are never
+	// removed, even when they were also listed as additional tags.
+	BaseTags []string
+}
+
+// ReconcileClusterTags makes sure the cluster resources carry the additional
+// tags of the cluster. Tags previously applied by the provider and since
+// dropped from the spec are removed, all other tags are left alone.
+func (s *Service) ReconcileClusterTags(resources ...TaggedResource) error {
+	desired := s.scope.AdditionalTags()
+
+	for _, tag := range desired {
+		var untagged []godo.Resource
+		for _, r := range resources {
+			if !slices.Contains(r.Tags, tag) {
+				untagged = append(untagged, r.Resource)
+			}
+		}
+		if len(untagged) == 0 {
+			continue
+		}
+		s.scope.V(2).Info("Tagging cluster resources", "tag", tag, "resources", untagged)
+		if err := s.tagResources(tag, untagged); err != nil {
+			return err
+		}
+	}
+	for _, tag := range s.scope.AppliedTags() {
+		if slices.Contains(desired, tag) {
+			continue
+		}
+		var tagged []godo.Resource
+		for _, r := range resources {
+			if slices.Contains(r.Tags, tag) && !slices.Contains(r.BaseTags, tag) {
+				tagged = append(tagged, r.Resource)
+			}
+		}
+		if len(tagged) == 0 {
+			continue
+		}
+		s.scope.V(2).Info("Untagging cluster resources", "tag", tag, "resources", tagged)
+		if err := s.untagResources(tag, tagged); err != nil {
+			return err
+		}
+	}
+
+	s.scope.SetAppliedTags(desired)
+	return nil
+}
+
+// tagResources tags resources with tag, creating the tag if needed.
+func (s *Service) tagResources(tag string, resources []godo.Resource) error {
+	if len(resources) == 0 {
+		return nil
+	}
+	if _, _, err := s.scope.Tags.Create(s.ctx, &godo.TagCreateRequest{Name: tag}); err != nil {
+		return errors.Wrapf(err, "failed to create tag %q", tag)
+	}
+	if _, err := s.scope.Tags.TagResources(s.ctx, tag, &godo.TagResourcesRequest{Resources: resources}); err != nil {
+		return errors.Wrapf(err, "failed to tag resources with %q", tag)
+	}
+	return nil
+}
+
+// untagResources removes tag from resources. A tag which no longer exists is
+// not an error.
+func (s *Service) untagResources(tag string, resources []godo.Resource) error {
+	if len(resources) == 0 {
+		return nil
+	}
+	res, err := s.scope.Tags.UntagResources(s.ctx, tag, &godo.UntagResourcesRequest{Resources: resources})
+	if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+		return errors.Wrapf(err, "failed to untag resources from %q", tag)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computes
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes/mock_computes"
+)
+
+func TestService_ReconcileDropletTags(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	dropletResource := godo.Resource{ID: "1234", Type: godo.DropletResourceType}
+	volumeResource := godo.Resource{ID: "vol-1", Type: godo.VolumeResourceType}
+
+	tests := []struct {
+		name        string
+		clusterTags infrav1.Tags
+		desired     infrav1.Tags
+		applied     infrav1.Tags
+		dropletTags []string
+		dataDisks   []infrav1.DataDisk
+		expect      func(mt *mock_computes.MockTagsServiceMockRecorder, ms *mock_computes.MockStorageServiceMockRecorder)
+		wantApplied infrav1.Tags
+		wantErr     bool
+	}{
+		{
+			name:        "tags are up to date",
+			desired:     infrav1.Tags{"billing"},
+			applied:     infrav1.Tags{"billing"},
+			dropletTags: []string{"billing"},
+			expect:      func(_ *mock_computes.MockTagsServiceMockRecorder, _ *mock_computes.MockStorageServiceMockRecorder) {},
+			wantApplied: infrav1.Tags{"billing"},
+		},
+		{
+			name:        "added tag is applied to the droplet and its data disks",
+			desired:     infrav1.Tags{"billing", "firewall"},
+			applied:     infrav1.Tags{"billing"},
+			dropletTags: []string{"billing"},
+			dataDisks:   []infrav1.DataDisk{{NameSuffix: "etcd"}},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder, ms *mock_computes.MockStorageServiceMockRecorder) {
+				ms.ListVolumes(gomock.Any(), &godo.ListVolumeParams{Name: "capdo-test-0-etcd", Region: "nyc1"}).Return([]godo.Volume{{ID: "vol-1"}}, nil, nil)
+				mt.Create(gomock.Any(), &godo.TagCreateRequest{Name: "firewall"}).Return(&godo.Tag{Name: "firewall"}, nil, nil)
+				mt.TagResources(gomock.Any(), "firewall", &godo.TagResourcesRequest{Resources: []godo.Resource{dropletResource, volumeResource}}).Return(nil, nil)
+			},
+			wantApplied: infrav1.Tags{"billing", "firewall"},
+		},
+		{
+			name:        "cluster tags are applied before the machine tags",
+			clusterTags: infrav1.Tags{"team", "billing"},
+			desired:     infrav1.Tags{"billing"},
+			applied:     infrav1.Tags{"billing"},
+			dropletTags: []string{"billing"},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder, _ *mock_computes.MockStorageServiceMockRecorder) {
+				mt.Create(gomock.Any(), &godo.TagCreateRequest{Name: "team"}).Return(&godo.Tag{Name: "team"}, nil, nil)
+				mt.TagResources(gomock.Any(), "team", &godo.TagResourcesRequest{Resources: []godo.Resource{dropletResource}}).Return(nil, nil)
+			},
+			wantApplied: infrav1.Tags{"team", "billing"},
+		},
+		{
+			name:        "removed tag is untagged, cluster tags and foreign tags are kept",
+			desired:     infrav1.Tags{},
+			applied:     infrav1.Tags{"billing", infrav1.ClusterNameTag("capdo-test")},
+			dropletTags: []string{"billing", "manual", infrav1.ClusterNameTag("capdo-test")},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder, _ *mock_computes.MockStorageServiceMockRecorder) {
+				mt.UntagResources(gomock.Any(), "billing", &godo.UntagResourcesRequest{Resources: []godo.Resource{dropletResource}}).Return(nil, nil)
+			},
+			wantApplied: infrav1.Tags{},
+		},
+		{
+			name:        "removed tag that no longer exists",
+			desired:     infrav1.Tags{},
+			applied:     infrav1.Tags{"billing"},
+			dropletTags: []string{},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder, _ *mock_computes.MockStorageServiceMockRecorder) {
+				mt.UntagResources(gomock.Any(), "billing", gomock.Any()).
+					Return(&godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("tag not found"))
+			},
+			wantApplied: infrav1.Tags{},
+		},
+		{
+			name:        "failed tagging (should return an error)",
+			desired:     infrav1.Tags{"billing"},
+			dropletTags: []string{},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder, _ *mock_computes.MockStorageServiceMockRecorder) {
+				mt.Create(gomock.Any(), &godo.TagCreateRequest{Name: "billing"}).Return(&godo.Tag{Name: "billing"}, nil, nil)
+				mt.TagResources(gomock.Any(), "billing", gomock.Any()).Return(nil, errors.New("error tagging droplet"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mtags := mock_computes.NewMockTagsService(mctrl)
+			mstorage := mock_computes.NewMockStorageService(mctrl)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cluster := &clusterv1beta2.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capdo-test"}}
+			docluster := &infrav1.DOCluster{Spec: infrav1.DOClusterSpec{Region: "nyc1", AdditionalTags: tt.clusterTags}}
+			cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:    client,
+				Cluster:   cluster,
+				DOCluster: docluster,
+				DOClients: scope.DOClients{
+					Tags:    mtags,
+					Storage: mstorage,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+			mscope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:    client,
+				Cluster:   cluster,
+				Machine:   &clusterv1beta2.Machine{},
+				DOCluster: docluster,
+				DOMachine: &infrav1.DOMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test-0"},
+					Spec:       infrav1.DOMachineSpec{AdditionalTags: tt.desired, DataDisks: tt.dataDisks},
+					Status:     infrav1.DOMachineStatus{AppliedTags: tt.applied},
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tt.expect(mtags.EXPECT(), mstorage.EXPECT())
+			s := NewService(ctx, cscope)
+			err = s.ReconcileDropletTags(mscope, &godo.Droplet{ID: 1234, Tags: tt.dropletTags})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.ReconcileDropletTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(mscope.AppliedTags(), tt.wantApplied) {
+				t.Errorf("Service.ReconcileDropletTags() applied tags = %v, want %v", mscope.AppliedTags(), tt.wantApplied)
+			}
+		})
+	}
+}
+
+func TestService_ReconcileClusterTags(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	lbResource := godo.Resource{ID: "lb-1", Type: godo.LoadBalancerResourceType}
+	bastionResource := godo.Resource{ID: "1234", Type: godo.DropletResourceType}
+
+	tests := []struct {
+		name        string
+		desired     infrav1.Tags
+		applied     infrav1.Tags
+		resources   []TaggedResource
+		expect      func(mt *mock_computes.MockTagsServiceMockRecorder)
+		wantApplied infrav1.Tags
+		wantErr     bool
+	}{
+		{
+			name:    "tags are up to date",
+			desired: infrav1.Tags{"billing"},
+			applied: infrav1.Tags{"billing"},
+			resources: []TaggedResource{
+				{Resource: lbResource, Tags: []string{"billing"}},
+				{Resource: bastionResource, Tags: []string{"billing"}},
+			},
+			expect:      func(_ *mock_computes.MockTagsServiceMockRecorder) {},
+			wantApplied: infrav1.Tags{"billing"},
+		},
+		{
+			name:    "tags are applied to resources created before they were set",
+			desired: infrav1.Tags{"billing"},
+			resources: []TaggedResource{
+				{Resource: lbResource},
+				{Resource: bastionResource, Tags: []string{"billing"}},
+			},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder) {
+				mt.Create(gomock.Any(), &godo.TagCreateRequest{Name: "billing"}).Return(&godo.Tag{Name: "billing"}, nil, nil)
+				mt.TagResources(gomock.Any(), "billing", &godo.TagResourcesRequest{Resources: []godo.Resource{lbResource}}).Return(nil, nil)
+			},
+			wantApplied: infrav1.Tags{"billing"},
+		},
+		{
+			name:    "updated tags are applied and removed tags are untagged",
+			desired: infrav1.Tags{"team"},
+			applied: infrav1.Tags{"billing", infrav1.ClusterNameTag("capdo-test")},
+			resources: []TaggedResource{
+				{
+					Resource: lbResource,
+					Tags:     []string{"billing", "manual", infrav1.ClusterNameTag("capdo-test")},
+					BaseTags: []string{infrav1.ClusterNameTag("capdo-test")},
+				},
+				{
+					Resource: bastionResource,
+					Tags:     []string{infrav1.ClusterNameTag("capdo-test")},
+					BaseTags: []string{infrav1.ClusterNameTag("capdo-test")},
+				},
+			},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder) {
+				mt.Create(gomock.Any(), &godo.TagCreateRequest{Name: "team"}).Return(&godo.Tag{Name: "team"}, nil, nil)
+				mt.TagResources(gomock.Any(), "team", &godo.TagResourcesRequest{Resources: []godo.Resource{lbResource, bastionResource}}).Return(nil, nil)
+				mt.UntagResources(gomock.Any(), "billing", &godo.UntagResourcesRequest{Resources: []godo.Resource{lbResource}}).Return(nil, nil)
+			},
+			wantApplied: infrav1.Tags{"team"},
+		},
+		{
+			name:      "failed tagging (should return an error)",
+			desired:   infrav1.Tags{"billing"},
+			resources: []TaggedResource{{Resource: lbResource}},
+			expect: func(mt *mock_computes.MockTagsServiceMockRecorder) {
+				mt.Create(gomock.Any(), &godo.TagCreateRequest{Name: "billing"}).Return(&godo.Tag{Name: "billing"}, nil, nil)
+				mt.TagResources(gomock.Any(), "billing", gomock.Any()).Return(nil, errors.New("error tagging load balancer"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mtags := mock_computes.NewMockTagsService(mctrl)
+			docluster := &infrav1.DOCluster{
+				Spec:   infrav1.DOClusterSpec{AdditionalTags: tt.desired},
+				Status: infrav1.DOClusterStatus{AppliedTags: tt.applied},
+			}
+			cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster:   &clusterv1beta2.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capdo-test"}},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{
+					Tags: mtags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tt.expect(mtags.EXPECT())
+			err = NewService(context.TODO(), cscope).ReconcileClusterTags(tt.resources...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.ReconcileClusterTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			wantApplied := tt.wantApplied
+			if tt.wantErr {
+				wantApplied = tt.applied
+			}
+			if !reflect.DeepEqual(cscope.AppliedTags(), wantApplied) {
+				t.Errorf("Service.ReconcileClusterTags() applied tags = %v, want %v", cscope.AppliedTags(), wantApplied)
+			}
+		})
+	}
+}
@@ -22,7 +22,8 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/pkg/errors"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
 )
 
 // GetVolumeByName takes a volume name and returns a Volume if found.
@@ -43,14 +44,21 @@ func (s *Service) GetVolumeByName(name string) (*godo.Volume, error) {
 	return &vols[0], nil
 }
 
-// CreateVolume creates a block storage volume.
-func (s *Service) CreateVolume(disk infrav1.DataDisk, volName string) (*godo.Volume, error) {
+// CreateVolume creates a block storage volume for a data disk of the machine.
+func (s *Service) CreateVolume(scope *scope.MachineScope, disk infrav1.DataDisk, volName string) (*godo.Volume, error) {
 	r := &godo.VolumeCreateRequest{
 		Region:          s.scope.Region(),
 		Name:            volName,
 		SizeGigaBytes:   disk.DiskSizeGB,
 		FilesystemType:  disk.FilesystemType,
 		FilesystemLabel: disk.FilesystemLabel,
+		Tags: infrav1.BuildTags(infrav1.BuildTagParams{
+			ClusterName: infrav1.DOSafeName(s.scope.Name()),
+			ClusterUID:  s.scope.UID(),
+			Name:        volName,
+			Role:        scope.Role(),
+			Additional:  scope.AdditionalTags(),
+		}),
 	}
 	v, _, err := s.scope.Storage.CreateVolume(s.ctx, r)
 	return v, errors.Wrap(err, "failed to create new volume")
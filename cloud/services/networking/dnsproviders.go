@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// DNSRecord is a DNS record managed through a DNSProvider. Name is relative to
+// the domain the record lives in.
+type DNSRecord struct {
+	Name string
+	Type string
+	Data string
+	TTL  int
+}
+
+// DNSProvider manages the DNS records of the domains used by a cluster.
+type DNSProvider interface {
+	// GetRecord returns the record of the given name and type, or nil if it
+	// does not exist.
+	GetRecord(ctx context.Context, domain, name, rType string) (*DNSRecord, error)
+	// UpsertRecord creates the record or replaces the existing one of the
+	// same name and type.
+	UpsertRecord(ctx context.Context, domain string, record *DNSRecord) error
+	// DeleteRecord removes the record of the given name and type if it exists.
+	DeleteRecord(ctx context.Context, domain, name, rType string) error
+}
+
+// DNSProvider returns the DNS provider configured for the cluster.
+func (s *Service) DNSProvider() (DNSProvider, error) {
+	if s.dns != nil {
+		return s.dns, nil
+	}
+
+	var provider DNSProvider
+	config := s.scope.DNSProvider()
+	switch config.Type {
+	case infrav1.DODNSProviderDigitalOcean:
+		provider = &doDNSProvider{domains: s.scope.Domains}
+	case infrav1.DODNSProviderRFC2136:
+		if config.RFC2136 == nil {
+			return nil, errors.New("rfc2136 DNS provider configuration is missing")
+		}
+		var credentials map[string][]byte
+		if ref := config.RFC2136.CredentialsSecretRef; ref != nil {
+			var err error
+			if credentials, err = s.scope.GetSecretData(s.ctx, ref.Name); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if provider, err = newRFC2136DNSProvider(config.RFC2136.Server, credentials); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("unsupported DNS provider type %q", config.Type)
+	}
+
+	s.dns = provider
+	return provider, nil
+}
+
+// doDNSProvider manages records through the DigitalOcean Domains API.
+type doDNSProvider struct {
+	domains godo.DomainsService
+}
+
+func (p *doDNSProvider) getRecord(ctx context.Context, domain, name, rType string) (*godo.DomainRecord, error) {
+	fqdn := fmt.Sprintf("%s.%s", name, domain)
+	records, resp, err := p.domains.RecordsByTypeAndName(ctx, domain, rType, fqdn, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	switch len(records) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &records[0], nil
+	default:
+		return nil, fmt.Errorf("multiple DNS records (%d) found for '%s.%s' type %s",
+			len(records), name, domain, rType)
+	}
+}
+
+func (p *doDNSProvider) GetRecord(ctx context.Context, domain, name, rType string) (*DNSRecord, error) {
+	record, err := p.getRecord(ctx, domain, name, rType)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return &DNSRecord{Name: name, Type: record.Type, Data: record.Data, TTL: record.TTL}, nil
+}
+
+func (p *doDNSProvider) UpsertRecord(ctx context.Context, domain string, record *DNSRecord) error {
+	current, err := p.getRecord(ctx, domain, record.Name, record.Type)
+	if err != nil {
+		return err
+	}
+	recordReq := &godo.DomainRecordEditRequest{
+		Type: record.Type,
+		Name: record.Name,
+		Data: record.Data,
+		TTL:  record.TTL,
+	}
+	if current == nil {
+		_, _, err = p.domains.CreateRecord(ctx, domain, recordReq)
+	} else {
+		_, _, err = p.domains.EditRecord(ctx, domain, current.ID, recordReq)
+	}
+	return err
+}
+
+func (p *doDNSProvider) DeleteRecord(ctx context.Context, domain, name, rType string) error {
+	record, err := p.getRecord(ctx, domain, name, rType)
+	if err != nil || record == nil {
+		return err
+	}
+	_, err = p.domains.DeleteRecord(ctx, domain, record.ID)
+	return err
+}
@@ -18,61 +18,169 @@ package networking
 
 import (
 	"fmt"
-	"net/http"
+	"strings"
 
-	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
 )
 
-// GetDomainRecord retrieves a single domain record from DO.
-func (s *Service) GetDomainRecord(domain, name, rType string) (*godo.DomainRecord, error) {
-	fqdn := fmt.Sprintf("%s.%s", name, domain)
-	records, resp, err := s.scope.Domains.RecordsByTypeAndName(s.ctx, domain, rType, fqdn, nil)
+const (
+	// ownershipRecordPrefix prefixes the name of the TXT records holding the
+	// owner of the records managed by CAPDO, in the style of external-dns.
+	ownershipRecordPrefix = "capdo-"
+	ownershipHeritage     = "cluster-api-provider-digitalocean"
+	ownershipOwnerKey     = "capdo/owner"
+)
+
+// ErrDomainRecordNotOwned is returned when a domain record is not owned by the
+// cluster trying to modify it.
+var ErrDomainRecordNotOwned = errors.New("domain record is not owned by this cluster")
+
+// GetDomainRecord retrieves a single domain record from the DNS provider.
+func (s *Service) GetDomainRecord(domain, name, rType string) (*DNSRecord, error) {
+	provider, err := s.DNSProvider()
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return nil, nil
-		}
 		return nil, err
 	}
-	switch len(records) {
-	case 0:
-		return nil, nil
-	case 1:
-		return &records[0], nil
-	default:
-		return nil, fmt.Errorf("multiple DNS records (%d) found for '%s.%s' type %s",
-			len(records), name, domain, rType)
-	}
+	return provider.GetRecord(s.ctx, domain, name, rType)
 }
 
-// UpsertDomainRecord creates or updates a DO domain record.
-func (s *Service) UpsertDomainRecord(domain, name, rType, data string) error {
-	record, err := s.GetDomainRecord(domain, name, rType)
+// UpsertDomainRecord creates or updates a domain record through the DNS provider.
+func (s *Service) UpsertDomainRecord(domain, name, rType, data string, ttl int) error {
+	provider, err := s.DNSProvider()
 	if err != nil {
-		return fmt.Errorf("unable to get current DNS record from API: %s", err)
+		return err
 	}
-	recordReq := &godo.DomainRecordEditRequest{
-		Type: rType,
+	return provider.UpsertRecord(s.ctx, domain, &DNSRecord{
 		Name: name,
+		Type: rType,
 		Data: data,
-		TTL:  30,
+		TTL:  ttl,
+	})
+}
+
+// DeleteDomainRecord removes a domain record through the DNS provider.
+func (s *Service) DeleteDomainRecord(domain, name, rType string) error {
+	provider, err := s.DNSProvider()
+	if err != nil {
+		return err
 	}
-	if record == nil {
-		_, _, err = s.scope.Domains.CreateRecord(s.ctx, domain, recordReq)
-	} else {
-		_, _, err = s.scope.Domains.EditRecord(s.ctx, domain, record.ID, recordReq)
+	return provider.DeleteRecord(s.ctx, domain, name, rType)
+}
+
+// OwnershipRecordName returns the name of the TXT record holding the owner of
+// the record of the given name and type, e.g. capdo-a-api for the A record api.
+// As in external-dns, a wildcard is replaced by "any".
+func OwnershipRecordName(name, rType string) string {
+	return fmt.Sprintf("%s%s-%s", ownershipRecordPrefix, strings.ToLower(rType), strings.ReplaceAll(name, "*", "any"))
+}
+
+// ownershipRecordData returns the content of the TXT record marking owner as
+// owner of a record.
+func ownershipRecordData(owner string) string {
+	return fmt.Sprintf("heritage=%s,%s=%s", ownershipHeritage, ownershipOwnerKey, owner)
+}
+
+// parseOwnershipRecordData returns the owner stored in an ownership TXT
+// record, or an empty string if the record was not written by CAPDO.
+func parseOwnershipRecordData(data string) string {
+	var heritage, owner string
+	for _, label := range strings.Split(strings.Trim(data, `"`), ",") {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "heritage":
+			heritage = value
+		case ownershipOwnerKey:
+			owner = value
+		}
 	}
-	return err
+	if heritage != ownershipHeritage {
+		return ""
+	}
+	return owner
 }
 
-// DeleteDomainRecord removes a DO domain record.
-func (s *Service) DeleteDomainRecord(domain, name, rType string) error {
+// GetDomainRecordOwner returns the owner of a domain record as recorded in its
+// ownership TXT record, or an empty string if the record has no owner.
+func (s *Service) GetDomainRecordOwner(domain, name, rType string) (string, error) {
+	txt, err := s.GetDomainRecord(domain, OwnershipRecordName(name, rType), "TXT")
+	if err != nil || txt == nil {
+		return "", err
+	}
+	return parseOwnershipRecordData(txt.Data), nil
+}
+
+// UpsertOwnedDomainRecord creates or updates a domain record owned by owner,
+// along with its ownership TXT record. Records owned by someone else are never
+// modified. An existing record without any owner is only taken over if adopt
+// is set. It returns whether the record was created or changed.
+func (s *Service) UpsertOwnedDomainRecord(domain, name, rType, data string, ttl int, owner string, adopt bool) (bool, error) {
+	currentOwner, err := s.GetDomainRecordOwner(domain, name, rType)
+	if err != nil {
+		return false, fmt.Errorf("unable to get current DNS ownership record from API: %s", err)
+	}
 	record, err := s.GetDomainRecord(domain, name, rType)
 	if err != nil {
-		return fmt.Errorf("unable to get current DNS record from API: %s", err)
+		return false, fmt.Errorf("unable to get current DNS record from API: %s", err)
+	}
+
+	switch {
+	case currentOwner == owner:
+	case currentOwner != "":
+		return false, errors.Wrapf(ErrDomainRecordNotOwned, "%s record %s.%s is owned by %s", rType, name, domain, currentOwner)
+	case record != nil && !adopt:
+		return false, errors.Wrapf(ErrDomainRecordNotOwned, "%s record %s.%s already exists", rType, name, domain)
+	default:
+		// Claim the record before touching it.
+		if err := s.UpsertDomainRecord(domain, OwnershipRecordName(name, rType), "TXT", ownershipRecordData(owner), ttl); err != nil {
+			return false, fmt.Errorf("unable to create DNS ownership record: %s", err)
+		}
 	}
-	if record == nil {
-		return nil
+
+	if record != nil && sameRecordData(rType, record.Data, data) && record.TTL == ttl {
+		return false, nil
+	}
+	return true, s.UpsertDomainRecord(domain, name, rType, data, ttl)
+}
+
+// DeleteOwnedDomainRecord removes a domain record owned by owner along with
+// its ownership TXT record. Records owned by someone else are never deleted.
+// An existing record without any owner is only deleted if adopt is set.
+func (s *Service) DeleteOwnedDomainRecord(domain, name, rType, owner string, adopt bool) error {
+	currentOwner, err := s.GetDomainRecordOwner(domain, name, rType)
+	if err != nil {
+		return fmt.Errorf("unable to get current DNS ownership record from API: %s", err)
+	}
+
+	if currentOwner != owner {
+		if currentOwner != "" {
+			return errors.Wrapf(ErrDomainRecordNotOwned, "%s record %s.%s is owned by %s", rType, name, domain, currentOwner)
+		}
+		if !adopt {
+			record, err := s.GetDomainRecord(domain, name, rType)
+			if err != nil {
+				return fmt.Errorf("unable to get current DNS record from API: %s", err)
+			}
+			if record != nil {
+				return errors.Wrapf(ErrDomainRecordNotOwned, "%s record %s.%s has no owner", rType, name, domain)
+			}
+			return nil
+		}
+	}
+
+	if err := s.DeleteDomainRecord(domain, name, rType); err != nil {
+		return err
+	}
+	return s.DeleteDomainRecord(domain, OwnershipRecordName(name, rType), "TXT")
+}
+
+// sameRecordData returns whether the data of two records of the given type
+// are equivalent, host names being compared regardless of the trailing dot.
+func sameRecordData(rType, a, b string) bool {
+	if rType == "CNAME" {
+		return strings.TrimSuffix(a, ".") == strings.TrimSuffix(b, ".")
 	}
-	_, err = s.scope.Domains.DeleteRecord(s.ctx, domain, record.ID)
-	return err
+	return a == b
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+)
+
+func TestParseOwnershipRecordData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "owned record",
+			data: ownershipRecordData("1234"),
+			want: "1234",
+		},
+		{
+			name: "quoted owned record",
+			data: `"heritage=cluster-api-provider-digitalocean,capdo/owner=1234"`,
+			want: "1234",
+		},
+		{
+			name: "external-dns record",
+			data: `"heritage=external-dns,external-dns/owner=default"`,
+		},
+		{
+			name: "unrelated TXT record",
+			data: "v=spf1 -all",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseOwnershipRecordData(tt.data); got != tt.want {
+				t.Errorf("parseOwnershipRecordData() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+const (
+	// allPorts is the port range covering all ports of a protocol.
+	allPorts = "all"
+	// kubeletPort is the port of the kubelet API.
+	kubeletPort = "10250"
+	// etcdPorts is the port range of the etcd client and peer APIs.
+	etcdPorts = "2379-2380"
+	// sshPort is the port of the SSH daemon.
+	sshPort = "22"
+)
+
+var everywhere = []string{"0.0.0.0/0", "::/0"}
+
+// FirewallName returns the name of the firewall protecting the droplets with the given role.
+func (s *Service) FirewallName(role string) string {
+	return infrav1.DOSafeName(s.scope.Name()) + "-" + role + "-" + s.scope.UID()
+}
+
+// FirewallRequest builds the desired firewall for the droplets with the given role. The
+// API server is reachable from the load balancers with the given IDs, and the kubelet and
+// etcd from the given VPC IP range.
+func (s *Service) FirewallRequest(role string, lbIDs []string, vpcIPRange string) *godo.FirewallRequest {
+	clusterName := infrav1.DOSafeName(s.scope.Name())
+	clusterTags := []string{
+		infrav1.ClusterNameUIDRoleTag(clusterName, s.scope.UID(), infrav1.APIServerRoleTagValue),
+		infrav1.ClusterNameUIDRoleTag(clusterName, s.scope.UID(), infrav1.NodeRoleTagValue),
+	}
+
+	inbound := []godo.InboundRule{
+		// Allow all traffic between the cluster droplets, e.g. for the CNI.
+		{Protocol: "tcp", PortRange: allPorts, Sources: &godo.Sources{Tags: clusterTags}},
+		{Protocol: "udp", PortRange: allPorts, Sources: &godo.Sources{Tags: clusterTags}},
+		{Protocol: "icmp", Sources: &godo.Sources{Tags: clusterTags}},
+		{Protocol: "tcp", PortRange: kubeletPort, Sources: &godo.Sources{Addresses: []string{vpcIPRange}}},
+	}
+
+	if role == infrav1.APIServerRoleTagValue {
+		// The API server must also be reachable from inside the VPC since the
+		// in-cluster "kubernetes" service points at the droplet private IPs.
+		apiServerSources := &godo.Sources{Addresses: []string{vpcIPRange}}
+		if len(lbIDs) > 0 {
+			apiServerSources.LoadBalancerUIDs = lbIDs
+		}
+		// Without a load balancer, clients reach the API server directly
+		// through the reserved IP assigned to one of the droplets.
+		if s.scope.APIServerEndpointType() == infrav1.DOAPIServerEndpointTypeReservedIP {
+			apiServerSources.Addresses = append(apiServerSources.Addresses, everywhere...)
+		}
+		inbound = append(inbound,
+			godo.InboundRule{Protocol: "tcp", PortRange: fmt.Sprint(s.scope.APIServerLoadbalancers().Port), Sources: apiServerSources},
+			godo.InboundRule{Protocol: "tcp", PortRange: etcdPorts, Sources: &godo.Sources{Addresses: []string{vpcIPRange}}},
+		)
+
+		// The targets of the additional forwarding rules must be reachable from the LBs.
+		if len(lbIDs) > 0 {
+			rules := s.scope.APIServerLoadbalancers().AdditionalForwardingRules
+			if public := s.scope.APIServerPublicLoadbalancer(); public != nil {
+				rules = append(append([]infrav1.DOForwardingRule(nil), rules...), public.AdditionalForwardingRules...)
+			}
+			for _, rule := range rules {
+				protocol := rule.Protocol
+				if protocol == "http" {
+					protocol = "tcp"
+				}
+				inbound = append(inbound, godo.InboundRule{
+					Protocol:  protocol,
+					PortRange: fmt.Sprint(rule.Target()),
+					Sources:   &godo.Sources{LoadBalancerUIDs: lbIDs},
+				})
+			}
+		}
+	}
+
+	if s.scope.Bastion() != nil {
+		bastionTag := infrav1.ClusterNameUIDRoleTag(clusterName, s.scope.UID(), infrav1.BastionRoleTagValue)
+		inbound = append(inbound, godo.InboundRule{Protocol: "tcp", PortRange: sshPort, Sources: &godo.Sources{Tags: []string{bastionTag}}})
+	}
+
+	if spec := s.scope.Firewall(); spec != nil {
+		for _, rule := range spec.AdditionalInboundRules {
+			if rule.Role != "" && rule.Role != role {
+				continue
+			}
+			ports := rule.Ports
+			if ports == "" && rule.Protocol != "icmp" {
+				ports = allPorts
+			}
+			inbound = append(inbound, godo.InboundRule{
+				Protocol:  rule.Protocol,
+				PortRange: ports,
+				Sources:   &godo.Sources{Addresses: rule.Sources},
+			})
+		}
+	}
+
+	return &godo.FirewallRequest{
+		Name:          s.FirewallName(role),
+		InboundRules:  inbound,
+		OutboundRules: outboundRules(),
+		Tags:          []string{infrav1.ClusterNameUIDRoleTag(clusterName, s.scope.UID(), role)},
+	}
+}
+
+// BastionFirewallRequest builds the desired firewall for the bastion droplet, which
+// only allows SSH from the allowed CIDRs, or from everywhere if none are set.
+func (s *Service) BastionFirewallRequest(spec *infrav1.DOBastion) *godo.FirewallRequest {
+	clusterName := infrav1.DOSafeName(s.scope.Name())
+	sources := spec.AllowedCIDRs
+	if len(sources) == 0 {
+		sources = everywhere
+	}
+
+	return &godo.FirewallRequest{
+		Name: s.FirewallName(infrav1.BastionRoleTagValue),
+		InboundRules: []godo.InboundRule{
+			{Protocol: "tcp", PortRange: sshPort, Sources: &godo.Sources{Addresses: sources}},
+		},
+		OutboundRules: outboundRules(),
+		Tags:          []string{infrav1.ClusterNameUIDRoleTag(clusterName, s.scope.UID(), infrav1.BastionRoleTagValue)},
+	}
+}
+
+// outboundRules returns the outbound rules of the cluster firewalls, which
+// allow all traffic.
+func outboundRules() []godo.OutboundRule {
+	return []godo.OutboundRule{
+		{Protocol: "tcp", PortRange: allPorts, Destinations: &godo.Destinations{Addresses: everywhere}},
+		{Protocol: "udp", PortRange: allPorts, Destinations: &godo.Destinations{Addresses: everywhere}},
+		{Protocol: "icmp", Destinations: &godo.Destinations{Addresses: everywhere}},
+	}
+}
+
+// GetFirewall get a firewall by ID.
+func (s *Service) GetFirewall(id string) (*godo.Firewall, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	fw, res, err := s.scope.Firewalls.Get(s.ctx, id)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return fw, nil
+}
+
+// GetFirewallByName looks up a firewall by name.
+func (s *Service) GetFirewallByName(name string) (*godo.Firewall, error) {
+	opt := &godo.ListOptions{PerPage: 200}
+	for {
+		fws, res, err := s.scope.Firewalls.List(s.ctx, opt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list firewalls")
+		}
+
+		for i := range fws {
+			if fws[i].Name == name {
+				return &fws[i], nil
+			}
+		}
+
+		if res == nil || res.Links == nil || res.Links.IsLastPage() {
+			return nil, nil
+		}
+
+		page, err := res.Links.CurrentPage()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get current firewall list page")
+		}
+		opt.Page = page + 1
+	}
+}
+
+// CreateFirewall creates a firewall.
+func (s *Service) CreateFirewall(request *godo.FirewallRequest) (*godo.Firewall, error) {
+	if err := s.ensureFirewallTags(request); err != nil {
+		return nil, err
+	}
+
+	fw, _, err := s.scope.Firewalls.Create(s.ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return fw, nil
+}
+
+// UpdateFirewall replaces the rules and tags of a firewall.
+func (s *Service) UpdateFirewall(id string, request *godo.FirewallRequest) (*godo.Firewall, error) {
+	if err := s.ensureFirewallTags(request); err != nil {
+		return nil, err
+	}
+
+	fw, _, err := s.scope.Firewalls.Update(s.ctx, id, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return fw, nil
+}
+
+// DeleteFirewall delete a firewall by ID.
+func (s *Service) DeleteFirewall(id string) error {
+	if _, err := s.scope.Firewalls.Delete(s.ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ensureFirewallTags creates the tags referenced by the firewall since they
+// may not exist yet when the firewall is created before the droplets.
+func (s *Service) ensureFirewallTags(request *godo.FirewallRequest) error {
+	tags := map[string]struct{}{}
+	for _, t := range request.Tags {
+		tags[t] = struct{}{}
+	}
+	for _, rule := range request.InboundRules {
+		if rule.Sources == nil {
+			continue
+		}
+		for _, t := range rule.Sources.Tags {
+			tags[t] = struct{}{}
+		}
+	}
+
+	for t := range tags {
+		if _, _, err := s.scope.Tags.Create(s.ctx, &godo.TagCreateRequest{Name: t}); err != nil {
+			return errors.Wrapf(err, "failed to create tag %q", t)
+		}
+	}
+
+	return nil
+}
+
+// FirewallMatchesRequest returns true if the rules and tags of the firewall
+// match the request, ignoring ordering.
+func FirewallMatchesRequest(fw *godo.Firewall, request *godo.FirewallRequest) bool {
+	if !equalStrings(fw.Tags, request.Tags) {
+		return false
+	}
+
+	var have, want []string
+	for _, r := range fw.InboundRules {
+		have = append(have, firewallRuleKey("in", r.Protocol, r.PortRange, (*godo.Destinations)(r.Sources)))
+	}
+	for _, r := range fw.OutboundRules {
+		have = append(have, firewallRuleKey("out", r.Protocol, r.PortRange, r.Destinations))
+	}
+	for _, r := range request.InboundRules {
+		want = append(want, firewallRuleKey("in", r.Protocol, r.PortRange, (*godo.Destinations)(r.Sources)))
+	}
+	for _, r := range request.OutboundRules {
+		want = append(want, firewallRuleKey("out", r.Protocol, r.PortRange, r.Destinations))
+	}
+
+	return equalStrings(have, want)
+}
+
+// firewallRuleKey returns a canonical representation of a firewall rule.
+// DigitalOcean reports "all" ports and the ports of icmp rules as "0".
+func firewallRuleKey(direction, protocol, ports string, targets *godo.Destinations) string {
+	if ports == "" || ports == allPorts {
+		ports = "0"
+	}
+
+	var addresses, tags, lbs []string
+	if targets != nil {
+		addresses = sortedCopy(targets.Addresses)
+		tags = sortedCopy(targets.Tags)
+		lbs = sortedCopy(targets.LoadBalancerUIDs)
+	}
+
+	return strings.Join([]string{
+		direction,
+		protocol,
+		ports,
+		strings.Join(addresses, ","),
+		strings.Join(tags, ","),
+		strings.Join(lbs, ","),
+	}, "|")
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = sortedCopy(a), sortedCopy(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedCopy(in []string) []string {
+	out := append([]string(nil), in...)
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/digitalocean/godo"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestService_FirewallRequest(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1beta2.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "capdo-test",
+				UID:  types.UID("1234"),
+			},
+		},
+		DOCluster: &infrav1.DOCluster{
+			Spec: infrav1.DOClusterSpec{
+				Network: infrav1.DONetwork{
+					APIServerLoadbalancers: infrav1.DOLoadBalancer{Port: 6443},
+					Firewall: &infrav1.DOFirewall{
+						AdditionalInboundRules: []infrav1.DOFirewallInboundRule{
+							{Protocol: "tcp", Ports: "22", Sources: []string{"192.0.2.0/24"}},
+							{Protocol: "tcp", Sources: []string{"198.51.100.1"}, Role: infrav1.NodeRoleTagValue},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	s := NewService(context.TODO(), cscope)
+
+	tests := []struct {
+		name      string
+		role      string
+		wantTag   string
+		wantRules []godo.InboundRule
+		dontWant  []godo.InboundRule
+	}{
+		{
+			name:    "apiserver",
+			role:    infrav1.APIServerRoleTagValue,
+			wantTag: "sigs-k8s-io:capdo:capdo-test:1234:apiserver",
+			wantRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "6443", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}, LoadBalancerUIDs: []string{"lb-1"}}},
+				{Protocol: "tcp", PortRange: "2379-2380", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}}},
+				{Protocol: "tcp", PortRange: "10250", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}}},
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.0.2.0/24"}}},
+			},
+			dontWant: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "all", Sources: &godo.Sources{Addresses: []string{"198.51.100.1"}}},
+			},
+		},
+		{
+			name:    "node",
+			role:    infrav1.NodeRoleTagValue,
+			wantTag: "sigs-k8s-io:capdo:capdo-test:1234:node",
+			wantRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "10250", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}}},
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.0.2.0/24"}}},
+				{Protocol: "tcp", PortRange: "all", Sources: &godo.Sources{Addresses: []string{"198.51.100.1"}}},
+			},
+			dontWant: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "2379-2380", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := s.FirewallRequest(tt.role, []string{"lb-1"}, "10.10.0.0/16")
+			if len(req.Tags) != 1 || req.Tags[0] != tt.wantTag {
+				t.Errorf("Service.FirewallRequest() tags = %v, want %v", req.Tags, tt.wantTag)
+			}
+			for _, want := range tt.wantRules {
+				if !hasInboundRule(req.InboundRules, want) {
+					t.Errorf("Service.FirewallRequest() missing inbound rule %+v", want)
+				}
+			}
+			for _, dontWant := range tt.dontWant {
+				if hasInboundRule(req.InboundRules, dontWant) {
+					t.Errorf("Service.FirewallRequest() unexpected inbound rule %+v", dontWant)
+				}
+			}
+		})
+	}
+}
+
+func TestService_BastionFirewallRequest(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1beta2.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "capdo-test",
+				UID:  types.UID("1234"),
+			},
+		},
+		DOCluster: &infrav1.DOCluster{},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	s := NewService(context.TODO(), cscope)
+
+	tests := []struct {
+		name string
+		spec *infrav1.DOBastion
+		want godo.InboundRule
+	}{
+		{
+			name: "ssh from everywhere by default",
+			spec: &infrav1.DOBastion{},
+			want: godo.InboundRule{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"0.0.0.0/0", "::/0"}}},
+		},
+		{
+			name: "ssh from the allowed CIDRs",
+			spec: &infrav1.DOBastion{AllowedCIDRs: []string{"192.0.2.0/24"}},
+			want: godo.InboundRule{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.0.2.0/24"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := s.BastionFirewallRequest(tt.spec)
+			if len(req.InboundRules) != 1 || !hasInboundRule(req.InboundRules, tt.want) {
+				t.Errorf("Service.BastionFirewallRequest() inbound rules = %+v, want %+v", req.InboundRules, tt.want)
+			}
+			if len(req.Tags) != 1 || req.Tags[0] != "sigs-k8s-io:capdo:capdo-test:1234:bastion" {
+				t.Errorf("Service.BastionFirewallRequest() tags = %v", req.Tags)
+			}
+		})
+	}
+}
+
+func TestFirewallMatchesRequest(t *testing.T) {
+	request := &godo.FirewallRequest{
+		InboundRules: []godo.InboundRule{
+			{Protocol: "tcp", PortRange: "all", Sources: &godo.Sources{Tags: []string{"a", "b"}}},
+			{Protocol: "icmp", Sources: &godo.Sources{Tags: []string{"a", "b"}}},
+			{Protocol: "tcp", PortRange: "6443", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}}},
+		},
+		OutboundRules: []godo.OutboundRule{
+			{Protocol: "tcp", PortRange: "all", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0", "::/0"}}},
+		},
+		Tags: []string{"role"},
+	}
+
+	tests := []struct {
+		name string
+		fw   *godo.Firewall
+		want bool
+	}{
+		{
+			name: "same rules reported in DigitalOcean format",
+			fw: &godo.Firewall{
+				InboundRules: []godo.InboundRule{
+					{Protocol: "tcp", PortRange: "6443", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}}},
+					{Protocol: "icmp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"b", "a"}}},
+					{Protocol: "tcp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"a", "b"}}},
+				},
+				OutboundRules: []godo.OutboundRule{
+					{Protocol: "tcp", PortRange: "0", Destinations: &godo.Destinations{Addresses: []string{"::/0", "0.0.0.0/0"}}},
+				},
+				Tags: []string{"role"},
+			},
+			want: true,
+		},
+		{
+			name: "rule edited out-of-band",
+			fw: &godo.Firewall{
+				InboundRules: []godo.InboundRule{
+					{Protocol: "tcp", PortRange: "6443", Sources: &godo.Sources{Addresses: []string{"0.0.0.0/0"}}},
+					{Protocol: "icmp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"a", "b"}}},
+					{Protocol: "tcp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"a", "b"}}},
+				},
+				OutboundRules: []godo.OutboundRule{
+					{Protocol: "tcp", PortRange: "0", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0", "::/0"}}},
+				},
+				Tags: []string{"role"},
+			},
+			want: false,
+		},
+		{
+			name: "tag removed",
+			fw: &godo.Firewall{
+				InboundRules:  request.InboundRules,
+				OutboundRules: request.OutboundRules,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirewallMatchesRequest(tt.fw, request); got != tt.want {
+				t.Errorf("FirewallMatchesRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func hasInboundRule(rules []godo.InboundRule, want godo.InboundRule) bool {
+	for _, r := range rules {
+		if firewallRuleKey("in", r.Protocol, r.PortRange, (*godo.Destinations)(r.Sources)) ==
+			firewallRuleKey("in", want.Protocol, want.PortRange, (*godo.Destinations)(want.Sources)) {
+			return true
+		}
+	}
+	return false
+}
@@ -17,11 +17,16 @@ limitations under the License.
 package networking
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 )
 
 // GetLoadBalancer get a LB by LB ID.
@@ -41,22 +46,55 @@ func (s *Service) GetLoadBalancer(id string) (*godo.LoadBalancer, error) {
 	return lb, nil
 }
 
-// CreateLoadBalancer creates a LB.
-func (s *Service) CreateLoadBalancer(spec *infrav1.DOLoadBalancer) (*godo.LoadBalancer, error) {
-	clusterName := infrav1.DOSafeName(s.scope.Name())
-	name := clusterName + "-" + infrav1.APIServerRoleTagValue + "-" + s.scope.UID()
-	request := &godo.LoadBalancerRequest{
-		Name:      name,
-		Algorithm: spec.Algorithm,
-		Region:    s.scope.Region(),
-		ForwardingRules: []godo.ForwardingRule{
-			{
-				EntryProtocol:  "tcp",
-				EntryPort:      int(spec.Port),
-				TargetProtocol: "tcp",
-				TargetPort:     int(spec.Port),
-			},
+// LoadBalancerName returns the name of the API server load balancer.
+func (s *Service) LoadBalancerName() string {
+	return infrav1.DOSafeName(s.scope.Name()) + "-" + infrav1.APIServerRoleTagValue + "-" + s.scope.UID()
+}
+
+// PublicLoadBalancerName returns the name of the public API server load balancer.
+func (s *Service) PublicLoadBalancerName() string {
+	return infrav1.DOSafeName(s.scope.Name()) + "-" + infrav1.APIServerRoleTagValue + "-public-" + s.scope.UID()
+}
+
+// LoadBalancerRequest builds the desired API server load balancer from the spec.
+func (s *Service) LoadBalancerRequest(spec *infrav1.DOLoadBalancer) *godo.LoadBalancerRequest {
+	return s.loadBalancerRequest(s.LoadBalancerName(), spec)
+}
+
+// PublicLoadBalancerRequest builds the desired public API server load balancer from the spec.
+func (s *Service) PublicLoadBalancerRequest(spec *infrav1.DOLoadBalancer) *godo.LoadBalancerRequest {
+	request := s.loadBalancerRequest(s.PublicLoadBalancerName(), spec)
+	request.Network = infrav1.LBNetworkExternal
+	return request
+}
+
+func (s *Service) loadBalancerRequest(name string, spec *infrav1.DOLoadBalancer) *godo.LoadBalancerRequest {
+	forwardingRules := []godo.ForwardingRule{
+		{
+			EntryProtocol:  "tcp",
+			EntryPort:      int(spec.Port),
+			TargetProtocol: "tcp",
+			TargetPort:     int(spec.Port),
 		},
+	}
+	for _, rule := range spec.AdditionalForwardingRules {
+		forwardingRules = append(forwardingRules, godo.ForwardingRule{
+			EntryProtocol:  rule.Protocol,
+			EntryPort:      int(rule.EntryPort),
+			TargetProtocol: rule.Protocol,
+			TargetPort:     int(rule.Target()),
+		})
+	}
+
+	request := &godo.LoadBalancerRequest{
+		Name:                   name,
+		Algorithm:              spec.Algorithm,
+		Region:                 s.scope.Region(),
+		Network:                spec.Network,
+		SizeUnit:               uint32(spec.SizeUnit), //nolint:gosec
+		ForwardingRules:        forwardingRules,
+		EnableBackendKeepalive: spec.EnableBackendKeepalive,
+		EnableProxyProtocol:    spec.EnableProxyProtocol,
 		HealthCheck: &godo.HealthCheck{
 			Protocol:               "tcp",
 			Port:                   int(spec.Port),
@@ -65,10 +103,55 @@ func (s *Service) CreateLoadBalancer(spec *infrav1.DOLoadBalancer) (*godo.LoadBa
 			UnhealthyThreshold:     spec.HealthCheck.UnhealthyThreshold,
 			HealthyThreshold:       spec.HealthCheck.HealthyThreshold,
 		},
-		Tag:     infrav1.ClusterNameUIDRoleTag(clusterName, s.scope.UID(), infrav1.APIServerRoleTagValue),
-		VPCUUID: s.scope.VPC().VPCUUID,
+		Tag:     s.LoadBalancerTag(),
+		VPCUUID: s.scope.VPCUUID(),
+		// Tags are only taken into account when creating the LB, additional
+		// tags are reconciled afterwards through the tags API.
+		Tags: infrav1.BuildTags(infrav1.BuildTagParams{
+			ClusterName: infrav1.DOSafeName(s.scope.Name()),
+			ClusterUID:  s.scope.UID(),
+			Name:        name,
+			Role:        infrav1.APIServerRoleTagValue,
+			Additional:  s.scope.AdditionalTags(),
+		}),
+	}
+
+	// DigitalOcean only supports dual-stack on external load balancers.
+	if s.scope.DualStack() && request.Network != infrav1.LBNetworkInternal {
+		request.NetworkStack = godo.LoadBalancerNetworkStackDualstack
+	}
+
+	if spec.HTTPIdleTimeoutSeconds != 0 {
+		timeout := uint64(spec.HTTPIdleTimeoutSeconds) //nolint:gosec
+		request.HTTPIdleTimeoutSeconds = &timeout
 	}
 
+	if spec.Firewall != nil {
+		request.Firewall = &godo.LBFirewall{
+			Allow: lbFirewallRules(spec.Firewall.Allow),
+			Deny:  lbFirewallRules(spec.Firewall.Deny),
+		}
+	}
+
+	return request
+}
+
+// lbFirewallRules converts IP addresses and CIDRs to load balancer firewall
+// rules, which are in the form "ip:1.2.3.4" or "cidr:1.2.0.0/16".
+func lbFirewallRules(sources []string) []string {
+	rules := make([]string, 0, len(sources))
+	for _, src := range sources {
+		if strings.Contains(src, "/") {
+			rules = append(rules, "cidr:"+src)
+		} else {
+			rules = append(rules, "ip:"+src)
+		}
+	}
+	return rules
+}
+
+// CreateLoadBalancer creates a LB.
+func (s *Service) CreateLoadBalancer(request *godo.LoadBalancerRequest) (*godo.LoadBalancer, error) {
 	lb, _, err := s.scope.LoadBalancers.Create(s.ctx, request)
 	if err != nil {
 		return nil, err
@@ -77,6 +160,231 @@ func (s *Service) CreateLoadBalancer(spec *infrav1.DOLoadBalancer) (*godo.LoadBa
 	return lb, nil
 }
 
+// UpdateLoadBalancer applies the settings managed from the spec to a LB,
+// keeping its other settings such as its name and targets unchanged.
+func (s *Service) UpdateLoadBalancer(lb *godo.LoadBalancer, request *godo.LoadBalancerRequest) (*godo.LoadBalancer, error) {
+	update := lb.AsRequest()
+	for _, setting := range loadBalancerSettings {
+		if setting.defaulted && setting.value(request) == "" {
+			continue
+		}
+		setting.apply(update, request)
+	}
+	// AsRequest copies both the tag and the droplets the tag resolved to, but
+	// DigitalOcean rejects requests which set both.
+	if update.Tag != "" {
+		update.DropletIDs = nil
+	}
+
+	updated, _, err := s.scope.LoadBalancers.Update(s.ctx, lb.ID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// LoadBalancerDrift returns a description of each setting managed from the
+// spec which differs between the LB and the request.
+func LoadBalancerDrift(lb *godo.LoadBalancer, request *godo.LoadBalancerRequest) []string {
+	live := lb.AsRequest()
+
+	var drift []string
+	for _, setting := range loadBalancerSettings {
+		have, want := setting.value(live), setting.value(request)
+		// Settings left unset in the spec keep the value picked by DigitalOcean.
+		if setting.defaulted && want == "" {
+			continue
+		}
+		if have != want {
+			drift = append(drift, fmt.Sprintf("%s changed from %q to %q", setting.name, have, want))
+		}
+	}
+
+	return drift
+}
+
+// loadBalancerSetting is a LB setting managed from the DOLoadBalancer spec.
+type loadBalancerSetting struct {
+	name string
+	// defaulted is true if DigitalOcean picks a value when the setting is unset.
+	defaulted bool
+	// value returns a canonical representation of the setting, or an empty string if it is unset.
+	value func(r *godo.LoadBalancerRequest) string
+	// apply copies the setting from src to dst.
+	apply func(dst, src *godo.LoadBalancerRequest)
+}
+
+var loadBalancerSettings = []loadBalancerSetting{
+	{
+		name:  "algorithm",
+		value: func(r *godo.LoadBalancerRequest) string { return r.Algorithm },
+		apply: func(dst, src *godo.LoadBalancerRequest) { dst.Algorithm = src.Algorithm },
+	},
+	{
+		name: "forwarding rules",
+		value: func(r *godo.LoadBalancerRequest) string {
+			rules := make([]string, 0, len(r.ForwardingRules))
+			for _, f := range r.ForwardingRules {
+				rules = append(rules, fmt.Sprintf("%s:%d->%s:%d", f.EntryProtocol, f.EntryPort, f.TargetProtocol, f.TargetPort))
+			}
+			sort.Strings(rules)
+			return strings.Join(rules, ",")
+		},
+		apply: func(dst, src *godo.LoadBalancerRequest) {
+			dst.ForwardingRules = append([]godo.ForwardingRule(nil), src.ForwardingRules...)
+		},
+	},
+	{
+		name: "health check",
+		value: func(r *godo.LoadBalancerRequest) string {
+			hc := r.HealthCheck
+			if hc == nil {
+				return ""
+			}
+			return fmt.Sprintf("%s:%d%s interval=%ds timeout=%ds unhealthy=%d healthy=%d",
+				hc.Protocol, hc.Port, hc.Path, hc.CheckIntervalSeconds, hc.ResponseTimeoutSeconds, hc.UnhealthyThreshold, hc.HealthyThreshold)
+		},
+		apply: func(dst, src *godo.LoadBalancerRequest) {
+			if src.HealthCheck == nil {
+				dst.HealthCheck = nil
+				return
+			}
+			hc := *src.HealthCheck
+			dst.HealthCheck = &hc
+		},
+	},
+	{
+		name:      "size unit",
+		defaulted: true,
+		value: func(r *godo.LoadBalancerRequest) string {
+			if r.SizeUnit == 0 {
+				return ""
+			}
+			return strconv.FormatUint(uint64(r.SizeUnit), 10)
+		},
+		apply: func(dst, src *godo.LoadBalancerRequest) {
+			dst.SizeUnit = src.SizeUnit
+			dst.SizeSlug = ""
+		},
+	},
+	{
+		name:      "HTTP idle timeout",
+		defaulted: true,
+		value: func(r *godo.LoadBalancerRequest) string {
+			if r.HTTPIdleTimeoutSeconds == nil {
+				return ""
+			}
+			return strconv.FormatUint(*r.HTTPIdleTimeoutSeconds, 10)
+		},
+		apply: func(dst, src *godo.LoadBalancerRequest) {
+			timeout := *src.HTTPIdleTimeoutSeconds
+			dst.HTTPIdleTimeoutSeconds = &timeout
+		},
+	},
+	{
+		name:  "backend keepalive",
+		value: func(r *godo.LoadBalancerRequest) string { return strconv.FormatBool(r.EnableBackendKeepalive) },
+		apply: func(dst, src *godo.LoadBalancerRequest) { dst.EnableBackendKeepalive = src.EnableBackendKeepalive },
+	},
+	{
+		name:  "PROXY protocol",
+		value: func(r *godo.LoadBalancerRequest) string { return strconv.FormatBool(r.EnableProxyProtocol) },
+		apply: func(dst, src *godo.LoadBalancerRequest) { dst.EnableProxyProtocol = src.EnableProxyProtocol },
+	},
+	{
+		name: "firewall",
+		value: func(r *godo.LoadBalancerRequest) string {
+			if r.Firewall == nil || (len(r.Firewall.Allow) == 0 && len(r.Firewall.Deny) == 0) {
+				return ""
+			}
+			return "allow=" + strings.Join(sortedCopy(r.Firewall.Allow), ",") + " deny=" + strings.Join(sortedCopy(r.Firewall.Deny), ",")
+		},
+		apply: func(dst, src *godo.LoadBalancerRequest) {
+			switch {
+			case src.Firewall != nil:
+				dst.Firewall = &godo.LBFirewall{
+					Allow: append([]string{}, src.Firewall.Allow...),
+					Deny:  append([]string{}, src.Firewall.Deny...),
+				}
+			case dst.Firewall != nil:
+				// An empty firewall removes the rules of the LB.
+				dst.Firewall = &godo.LBFirewall{Allow: []string{}, Deny: []string{}}
+			}
+		},
+	},
+}
+
+// ValidateAdoptedLoadBalancer returns an error if a pre-existing LB is not in
+// the region and VPC of the cluster, or not on the network of the request. The
+// network of a LB cannot be changed once created.
+func (s *Service) ValidateAdoptedLoadBalancer(lb *godo.LoadBalancer, request *godo.LoadBalancerRequest) error {
+	if have, want := lbNetwork(lb.Network), lbNetwork(request.Network); have != want {
+		return errors.Errorf("load balancer %s is %s instead of %s", lb.ID, have, want)
+	}
+
+	if lb.Region == nil || lb.Region.Slug != s.scope.Region() {
+		region := ""
+		if lb.Region != nil {
+			region = lb.Region.Slug
+		}
+		return errors.Errorf("load balancer %s is in region %q instead of the cluster region %q", lb.ID, region, s.scope.Region())
+	}
+
+	vpc, err := s.GetClusterVPC()
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		return errors.New("unable to find the VPC of the cluster")
+	}
+	if lb.VPCUUID != vpc.ID {
+		return errors.Errorf("load balancer %s is in VPC %q instead of the cluster VPC %q", lb.ID, lb.VPCUUID, vpc.ID)
+	}
+
+	return nil
+}
+
+// lbNetwork returns the network of a LB, which is external when unset.
+func lbNetwork(network string) string {
+	if network == "" {
+		return infrav1.LBNetworkExternal
+	}
+	return network
+}
+
+// LoadBalancerTag returns the tag identifying the API server load balancers of the cluster.
+func (s *Service) LoadBalancerTag() string {
+	return infrav1.ClusterNameUIDRoleTag(infrav1.DOSafeName(s.scope.Name()), s.scope.UID(), infrav1.APIServerRoleTagValue)
+}
+
+// TagLoadBalancer tags a LB with the cluster role tag.
+func (s *Service) TagLoadBalancer(id string) error {
+	tag := s.LoadBalancerTag()
+	if _, _, err := s.scope.Tags.Create(s.ctx, &godo.TagCreateRequest{Name: tag}); err != nil {
+		return errors.Wrapf(err, "failed to create tag %q", tag)
+	}
+
+	if _, err := s.scope.Tags.TagResources(s.ctx, tag, &godo.TagResourcesRequest{
+		Resources: []godo.Resource{{ID: id, Type: godo.LoadBalancerResourceType}},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to tag load balancer %s", id)
+	}
+
+	return nil
+}
+
+// UntagLoadBalancer removes the cluster role tag from a LB.
+func (s *Service) UntagLoadBalancer(id string) error {
+	if _, err := s.scope.Tags.UntagResources(s.ctx, s.LoadBalancerTag(), &godo.UntagResourcesRequest{
+		Resources: []godo.Resource{{ID: id, Type: godo.LoadBalancerResourceType}},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to untag load balancer %s", id)
+	}
+
+	return nil
+}
+
 // DeleteLoadBalancer delete a LB by ID.
 func (s *Service) DeleteLoadBalancer(id string) error {
 	if _, err := s.scope.LoadBalancers.Delete(s.ctx, id); err != nil {
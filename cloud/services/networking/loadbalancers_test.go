@@ -27,7 +27,7 @@ import (
 	"github.com/digitalocean/godo"
 	"go.uber.org/mock/gomock"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/networking/mock_networking"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -148,3 +148,240 @@ func TestService_GetLoadBalancer(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadBalancerDrift(t *testing.T) {
+	request := &godo.LoadBalancerRequest{
+		Algorithm: "round_robin",
+		ForwardingRules: []godo.ForwardingRule{
+			{EntryProtocol: "tcp", EntryPort: 6443, TargetProtocol: "tcp", TargetPort: 6443},
+		},
+		HealthCheck: &godo.HealthCheck{Protocol: "tcp", Port: 6443, CheckIntervalSeconds: 10, ResponseTimeoutSeconds: 5, UnhealthyThreshold: 3, HealthyThreshold: 5},
+	}
+
+	tests := []struct {
+		name      string
+		lb        *godo.LoadBalancer
+		wantDrift int
+	}{
+		{
+			name: "in sync",
+			lb: &godo.LoadBalancer{
+				Name:            "my-lb",
+				Algorithm:       request.Algorithm,
+				ForwardingRules: request.ForwardingRules,
+				HealthCheck:     request.HealthCheck,
+				DropletIDs:      []int{1, 2},
+				// Picked by DigitalOcean when unset in the request.
+				SizeUnit:               1,
+				HTTPIdleTimeoutSeconds: godo.PtrTo(uint64(60)),
+			},
+		},
+		{
+			name: "firewall added out-of-band",
+			lb: &godo.LoadBalancer{
+				Algorithm:       request.Algorithm,
+				ForwardingRules: request.ForwardingRules,
+				HealthCheck:     request.HealthCheck,
+				Firewall:        &godo.LBFirewall{Deny: []string{"ip:192.0.2.1"}},
+			},
+			wantDrift: 1,
+		},
+		{
+			name: "port and health check changed",
+			lb: &godo.LoadBalancer{
+				Algorithm: request.Algorithm,
+				ForwardingRules: []godo.ForwardingRule{
+					{EntryProtocol: "tcp", EntryPort: 443, TargetProtocol: "tcp", TargetPort: 6443},
+				},
+				HealthCheck: &godo.HealthCheck{Protocol: "tcp", Port: 6443, CheckIntervalSeconds: 30, ResponseTimeoutSeconds: 5, UnhealthyThreshold: 3, HealthyThreshold: 5},
+			},
+			wantDrift: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LoadBalancerDrift(tt.lb, request); len(got) != tt.wantDrift {
+				t.Errorf("LoadBalancerDrift() = %v, want %d changes", got, tt.wantDrift)
+			}
+		})
+	}
+}
+
+func TestService_UpdateLoadBalancer(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mlbalancer := mock_networking.NewMockLoadBalancersService(mctrl)
+	cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster:   &clusterv1beta2.Cluster{},
+		DOCluster: &infrav1.DOCluster{},
+		DOClients: scope.DOClients{
+			LoadBalancers: mlbalancer,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	s := NewService(context.TODO(), cscope)
+
+	request := &godo.LoadBalancerRequest{
+		Name:      "capdo-lb",
+		Algorithm: "least_connections",
+		ForwardingRules: []godo.ForwardingRule{
+			{EntryProtocol: "tcp", EntryPort: 6443, TargetProtocol: "tcp", TargetPort: 6443},
+		},
+		HealthCheck: &godo.HealthCheck{Protocol: "tcp", Port: 6443},
+	}
+	want := &godo.LoadBalancerRequest{
+		Name:            "user-lb",
+		Algorithm:       "least_connections",
+		Region:          "nyc1",
+		Tag:             "user-tag",
+		ForwardingRules: request.ForwardingRules,
+		HealthCheck:     request.HealthCheck,
+	}
+
+	tests := []struct {
+		name string
+		lb   *godo.LoadBalancer
+	}{
+		{
+			name: "tagged load balancer",
+			lb: &godo.LoadBalancer{
+				ID:        "123456",
+				Name:      "user-lb",
+				Algorithm: "round_robin",
+				Region:    &godo.Region{Slug: "nyc1"},
+				Tag:       "user-tag",
+			},
+		},
+		{
+			name: "tagged load balancer with resolved droplets",
+			lb: &godo.LoadBalancer{
+				ID:         "123456",
+				Name:       "user-lb",
+				Algorithm:  "round_robin",
+				Region:     &godo.Region{Slug: "nyc1"},
+				Tag:        "user-tag",
+				DropletIDs: []int{1, 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mlbalancer.EXPECT().Update(gomock.Any(), "123456", want).Return(&godo.LoadBalancer{ID: "123456"}, nil, nil)
+
+			if _, err := s.UpdateLoadBalancer(tt.lb, request); err != nil {
+				t.Errorf("Service.UpdateLoadBalancer() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestService_LoadBalancerRequest(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster:   &clusterv1beta2.Cluster{},
+		DOCluster: &infrav1.DOCluster{},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	s := NewService(context.TODO(), cscope)
+
+	spec := &infrav1.DOLoadBalancer{
+		SizeUnit:               2,
+		HTTPIdleTimeoutSeconds: 120,
+		EnableBackendKeepalive: true,
+		Firewall: &infrav1.DOLoadBalancerFirewall{
+			Allow: []string{"192.0.2.0/24", "198.51.100.1"},
+		},
+		AdditionalForwardingRules: []infrav1.DOForwardingRule{
+			{Protocol: "tcp", EntryPort: 8132},
+			{Protocol: "http", EntryPort: 80, TargetPort: 8080},
+		},
+	}
+	spec.ApplyDefault()
+
+	got := s.LoadBalancerRequest(spec)
+	if got.SizeUnit != 2 || got.HTTPIdleTimeoutSeconds == nil || *got.HTTPIdleTimeoutSeconds != 120 || !got.EnableBackendKeepalive {
+		t.Errorf("Service.LoadBalancerRequest() = %+v, options not applied", got)
+	}
+	wantFirewall := &godo.LBFirewall{Allow: []string{"cidr:192.0.2.0/24", "ip:198.51.100.1"}, Deny: []string{}}
+	if !reflect.DeepEqual(got.Firewall, wantFirewall) {
+		t.Errorf("Service.LoadBalancerRequest() firewall = %+v, want %+v", got.Firewall, wantFirewall)
+	}
+	wantRules := []godo.ForwardingRule{
+		{EntryProtocol: "tcp", EntryPort: 6443, TargetProtocol: "tcp", TargetPort: 6443},
+		{EntryProtocol: "tcp", EntryPort: 8132, TargetProtocol: "tcp", TargetPort: 8132},
+		{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 8080},
+	}
+	if !reflect.DeepEqual(got.ForwardingRules, wantRules) {
+		t.Errorf("Service.LoadBalancerRequest() forwarding rules = %+v, want %+v", got.ForwardingRules, wantRules)
+	}
+}
+
+func TestService_ValidateAdoptedLoadBalancer(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	tests := []struct {
+		name    string
+		lb      *godo.LoadBalancer
+		expect  func(mvpc *mock_networking.MockVPCsServiceMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "in the cluster region and default VPC",
+			lb:   &godo.LoadBalancer{ID: "lb-1", Region: &godo.Region{Slug: "nyc1"}, VPCUUID: "vpc-default"},
+			expect: func(mvpc *mock_networking.MockVPCsServiceMockRecorder) {
+				mvpc.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-default", RegionSlug: "nyc1", Default: true},
+				}, &godo.Response{}, nil)
+			},
+		},
+		{
+			name:    "in another region",
+			lb:      &godo.LoadBalancer{ID: "lb-1", Region: &godo.Region{Slug: "ams3"}, VPCUUID: "vpc-default"},
+			expect:  func(_ *mock_networking.MockVPCsServiceMockRecorder) {},
+			wantErr: true,
+		},
+		{
+			name: "in another VPC",
+			lb:   &godo.LoadBalancer{ID: "lb-1", Region: &godo.Region{Slug: "nyc1"}, VPCUUID: "vpc-other"},
+			expect: func(mvpc *mock_networking.MockVPCsServiceMockRecorder) {
+				mvpc.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-default", RegionSlug: "nyc1", Default: true},
+				}, &godo.Response{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "on another network",
+			lb:      &godo.LoadBalancer{ID: "lb-1", Region: &godo.Region{Slug: "nyc1"}, VPCUUID: "vpc-default", Network: infrav1.LBNetworkInternal},
+			expect:  func(_ *mock_networking.MockVPCsServiceMockRecorder) {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mvpcs := mock_networking.NewMockVPCsService(mctrl)
+			s := newVPCTestService(t, mvpcs)
+
+			tt.expect(mvpcs.EXPECT())
+			if err := s.ValidateAdoptedLoadBalancer(tt.lb, &godo.LoadBalancerRequest{Network: infrav1.LBNetworkExternal}); (err != nil) != tt.wantErr {
+				t.Errorf("Service.ValidateAdoptedLoadBalancer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -15,5 +15,15 @@ limitations under the License.
 */
 
 //go:generate ../../../../hack/tools/bin/mockgen -destination loadbalancers_mock.go -package mock_networking github.com/digitalocean/godo LoadBalancersService
+//go:generate ../../../../hack/tools/bin/mockgen -destination vpcs_mock.go -package mock_networking github.com/digitalocean/godo VPCsService
+//go:generate ../../../../hack/tools/bin/mockgen -destination firewalls_mock.go -package mock_networking github.com/digitalocean/godo FirewallsService
+//go:generate ../../../../hack/tools/bin/mockgen -destination tags_mock.go -package mock_networking github.com/digitalocean/godo TagsService
+//go:generate ../../../../hack/tools/bin/mockgen -destination reservedips_mock.go -package mock_networking github.com/digitalocean/godo ReservedIPsService,ReservedIPActionsService
+//go:generate ../../../../hack/tools/bin/mockgen -destination domains_mock.go -package mock_networking github.com/digitalocean/godo DomainsService
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt loadbalancers_mock.go > _loadbalancers_mock.go && mv _loadbalancers_mock.go loadbalancers_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt vpcs_mock.go > _vpcs_mock.go && mv _vpcs_mock.go vpcs_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt firewalls_mock.go > _firewalls_mock.go && mv _firewalls_mock.go firewalls_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt tags_mock.go > _tags_mock.go && mv _tags_mock.go tags_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt reservedips_mock.go > _reservedips_mock.go && mv _reservedips_mock.go reservedips_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt domains_mock.go > _domains_mock.go && mv _domains_mock.go domains_mock.go"
 package mock_networking // nolint
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+)
+
+// GetReservedIP get a reserved IP by address.
+func (s *Service) GetReservedIP(ip string) (*godo.ReservedIP, error) {
+	if ip == "" {
+		return nil, nil
+	}
+
+	rip, res, err := s.scope.ReservedIPs.Get(s.ctx, ip)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return rip, nil
+}
+
+// CreateReservedIP reserves an IP in the cluster region.
+func (s *Service) CreateReservedIP() (*godo.ReservedIP, error) {
+	rip, _, err := s.scope.ReservedIPs.Create(s.ctx, &godo.ReservedIPCreateRequest{
+		Region: s.scope.Region(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rip, nil
+}
+
+// AssignReservedIP assigns a reserved IP to a droplet, moving it from the
+// droplet it is currently assigned to, if any.
+func (s *Service) AssignReservedIP(ip string, dropletID int) error {
+	if _, _, err := s.scope.ReservedIPActions.Assign(s.ctx, ip, dropletID); err != nil {
+		return errors.Wrapf(err, "failed to assign reserved IP %s to droplet %d", ip, dropletID)
+	}
+
+	return nil
+}
+
+// UnassignReservedIP unassigns a reserved IP from its droplet.
+func (s *Service) UnassignReservedIP(ip string) error {
+	if _, _, err := s.scope.ReservedIPActions.Unassign(s.ctx, ip); err != nil {
+		return errors.Wrapf(err, "failed to unassign reserved IP %s", ip)
+	}
+
+	return nil
+}
+
+// DeleteReservedIP releases a reserved IP.
+func (s *Service) DeleteReservedIP(ip string) error {
+	if _, err := s.scope.ReservedIPs.Delete(s.ctx, ip); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+const (
+	// RFC2136TSIGKeyNameKey is the credentials Secret key holding the TSIG key name.
+	RFC2136TSIGKeyNameKey = "tsigKeyName"
+	// RFC2136TSIGSecretKey is the credentials Secret key holding the base64 encoded TSIG secret.
+	RFC2136TSIGSecretKey = "tsigSecret"
+	// RFC2136TSIGAlgorithmKey is the credentials Secret key holding the TSIG algorithm.
+	RFC2136TSIGAlgorithmKey = "tsigAlgorithm"
+
+	rfc2136DefaultPort = "53"
+	rfc2136TSIGFudge   = 300
+)
+
+// rfc2136DNSProvider manages records on a DNS server accepting RFC2136
+// dynamic updates, optionally signed with TSIG.
+type rfc2136DNSProvider struct {
+	server        string
+	tsigKeyName   string
+	tsigSecret    string
+	tsigAlgorithm string
+}
+
+func newRFC2136DNSProvider(server string, credentials map[string][]byte) (*rfc2136DNSProvider, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, rfc2136DefaultPort)
+	}
+	p := &rfc2136DNSProvider{server: server}
+
+	if credentials == nil {
+		return p, nil
+	}
+	p.tsigKeyName = string(credentials[RFC2136TSIGKeyNameKey])
+	p.tsigSecret = string(credentials[RFC2136TSIGSecretKey])
+	if p.tsigKeyName == "" || p.tsigSecret == "" {
+		return nil, errors.Errorf("rfc2136 credentials must set both %s and %s", RFC2136TSIGKeyNameKey, RFC2136TSIGSecretKey)
+	}
+	p.tsigKeyName = dns.Fqdn(p.tsigKeyName)
+	p.tsigAlgorithm = dns.HmacSHA256
+	if algorithm := string(credentials[RFC2136TSIGAlgorithmKey]); algorithm != "" {
+		p.tsigAlgorithm = dns.Fqdn(strings.ToLower(algorithm))
+	}
+	return p, nil
+}
+
+func (p *rfc2136DNSProvider) exchange(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
+	c := &dns.Client{Net: "tcp"}
+	if p.tsigKeyName != "" {
+		c.TsigSecret = map[string]string{p.tsigKeyName: p.tsigSecret}
+		msg.SetTsig(p.tsigKeyName, p.tsigAlgorithm, rfc2136TSIGFudge, time.Now().Unix())
+	}
+
+	resp, _, err := c.ExchangeContext(ctx, msg, p.server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query DNS server %s", p.server)
+	}
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return nil, errors.Errorf("DNS server %s answered %s", p.server, dns.RcodeToString[resp.Rcode])
+	}
+	return resp, nil
+}
+
+func (p *rfc2136DNSProvider) GetRecord(ctx context.Context, domain, name, rType string) (*DNSRecord, error) {
+	qType, ok := dns.StringToType[rType]
+	if !ok {
+		return nil, errors.Errorf("unsupported DNS record type %s", rType)
+	}
+
+	fqdn := dns.Fqdn(fmt.Sprintf("%s.%s", name, domain))
+	msg := new(dns.Msg)
+	msg.SetQuestion(fqdn, qType)
+	resp, err := p.exchange(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The answer may also hold the records of the CNAME chain of the name.
+	var records []*DNSRecord
+	for _, rr := range resp.Answer {
+		if rr.Header().Rrtype != qType || !strings.EqualFold(rr.Header().Name, fqdn) {
+			continue
+		}
+		records = append(records, &DNSRecord{Name: name, Type: rType, Data: rdata(rr), TTL: int(rr.Header().Ttl)})
+	}
+	switch len(records) {
+	case 0:
+		return nil, nil
+	case 1:
+		return records[0], nil
+	default:
+		return nil, fmt.Errorf("multiple DNS records (%d) found for '%s.%s' type %s",
+			len(records), name, domain, rType)
+	}
+}
+
+func (p *rfc2136DNSProvider) UpsertRecord(ctx context.Context, domain string, record *DNSRecord) error {
+	rr, err := newRR(domain, record)
+	if err != nil {
+		return err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(domain))
+	msg.RemoveRRset([]dns.RR{rr})
+	msg.Insert([]dns.RR{rr})
+	_, err = p.exchange(ctx, msg)
+	return err
+}
+
+func (p *rfc2136DNSProvider) DeleteRecord(ctx context.Context, domain, name, rType string) error {
+	qType, ok := dns.StringToType[rType]
+	if !ok {
+		return errors.Errorf("unsupported DNS record type %s", rType)
+	}
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(domain))
+	msg.RemoveRRset([]dns.RR{&dns.ANY{Hdr: dns.RR_Header{
+		Name:   dns.Fqdn(fmt.Sprintf("%s.%s", name, domain)),
+		Rrtype: qType,
+		Class:  dns.ClassINET,
+	}}})
+	_, err := p.exchange(ctx, msg)
+	return err
+}
+
+// newRR builds the resource record of a DNSRecord living in domain.
+func newRR(domain string, record *DNSRecord) (dns.RR, error) {
+	hdr := dns.RR_Header{
+		Name:  dns.Fqdn(fmt.Sprintf("%s.%s", record.Name, domain)),
+		Class: dns.ClassINET,
+		Ttl:   uint32(record.TTL), //nolint:gosec
+	}
+	if record.Type == "TXT" {
+		hdr.Rrtype = dns.TypeTXT
+		return &dns.TXT{Hdr: hdr, Txt: []string{record.Data}}, nil
+	}
+
+	rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", hdr.Name, hdr.Ttl, record.Type, record.Data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s record data %q", record.Type, record.Data)
+	}
+	if rr == nil {
+		return nil, errors.Errorf("invalid %s record data %q", record.Type, record.Data)
+	}
+	return rr, nil
+}
+
+// rdata returns the data of a resource record, in the format used by the
+// DigitalOcean Domains API.
+func rdata(rr dns.RR) string {
+	switch r := rr.(type) {
+	case *dns.A:
+		return r.A.String()
+	case *dns.AAAA:
+		return r.AAAA.String()
+	case *dns.CNAME:
+		return r.Target
+	case *dns.TXT:
+		return strings.Join(r.Txt, "")
+	default:
+		return strings.TrimPrefix(rr.String(), rr.Header().String())
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	testTSIGKeyName = "capdo."
+	testTSIGSecret  = "dGhpcyBpcyBhIHRlc3QgVFNJRyBzZWNyZXQgZm9yIGNhcGRv"
+)
+
+// fakeRFC2136Server is a DNS server holding a single zone which only accepts
+// TSIG signed queries and updates.
+type fakeRFC2136Server struct {
+	mu      sync.Mutex
+	records map[string]dns.RR
+}
+
+func rrKey(name string, rType uint16) string {
+	return name + "/" + dns.TypeToString[rType]
+}
+
+func (f *fakeRFC2136Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	m := new(dns.Msg)
+	m.SetReply(r)
+	if r.IsTsig() == nil || w.TsigStatus() != nil {
+		m.Rcode = dns.RcodeRefused
+		_ = w.WriteMsg(m)
+		return
+	}
+
+	if r.Opcode == dns.OpcodeUpdate {
+		for _, rr := range r.Ns {
+			hdr := rr.Header()
+			if hdr.Class == dns.ClassANY {
+				delete(f.records, rrKey(hdr.Name, hdr.Rrtype))
+				continue
+			}
+			f.records[rrKey(hdr.Name, hdr.Rrtype)] = rr
+		}
+	} else {
+		q := r.Question[0]
+		if rr, ok := f.records[rrKey(q.Name, q.Qtype)]; ok {
+			m.Answer = append(m.Answer, rr)
+		}
+	}
+	m.SetTsig(testTSIGKeyName, dns.HmacSHA256, rfc2136TSIGFudge, time.Now().Unix())
+	_ = w.WriteMsg(m)
+}
+
+func startFakeRFC2136Server(t *testing.T) (string, *fakeRFC2136Server) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakeRFC2136Server{records: map[string]dns.RR{}}
+	started := make(chan struct{})
+	srv := &dns.Server{
+		Listener:          l,
+		Handler:           fake,
+		TsigSecret:        map[string]string{testTSIGKeyName: testTSIGSecret},
+		NotifyStartedFunc: func() { close(started) },
+		// The default accept function rejects dynamic updates.
+		MsgAcceptFunc: func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+	}
+	go func() { _ = srv.ActivateAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = srv.Shutdown() })
+
+	return l.Addr().String(), fake
+}
+
+func TestRFC2136DNSProvider(t *testing.T) {
+	ctx := context.TODO()
+	server, fake := startFakeRFC2136Server(t)
+
+	unsigned, err := newRFC2136DNSProvider(server, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unsigned.GetRecord(ctx, "example.com", "api", "A"); err == nil {
+		t.Fatalf("GetRecord() expected unsigned query to be refused")
+	}
+
+	p, err := newRFC2136DNSProvider(server, map[string][]byte{
+		RFC2136TSIGKeyNameKey: []byte("capdo"),
+		RFC2136TSIGSecretKey:  []byte(testTSIGSecret),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, record := range []*DNSRecord{
+		{Name: "api", Type: "A", Data: "192.0.2.1", TTL: 30},
+		{Name: "api", Type: "A", Data: "192.0.2.2", TTL: 60},
+		{Name: "capdo-a-api", Type: "TXT", Data: "heritage=cluster-api-provider-digitalocean,capdo/owner=1234", TTL: 30},
+	} {
+		if err := p.UpsertRecord(ctx, "example.com", record); err != nil {
+			t.Fatalf("UpsertRecord() error = %v", err)
+		}
+		got, err := p.GetRecord(ctx, "example.com", record.Name, record.Type)
+		if err != nil {
+			t.Fatalf("GetRecord() error = %v", err)
+		}
+		if got == nil || *got != *record {
+			t.Errorf("GetRecord() = %+v, want %+v", got, record)
+		}
+	}
+	if len(fake.records) != 2 {
+		t.Errorf("expected the A record to be replaced, got %d records", len(fake.records))
+	}
+
+	// Only answers for the queried name are considered, regardless of case.
+	fake.mu.Lock()
+	fake.records[rrKey("www.example.com.", dns.TypeA)] = &dns.A{
+		Hdr: dns.RR_Header{Name: "api.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30},
+		A:   net.ParseIP("192.0.2.2"),
+	}
+	fake.mu.Unlock()
+	if got, err := p.GetRecord(ctx, "example.com", "www", "A"); err != nil || got != nil {
+		t.Errorf("GetRecord() = %+v, %v for another name, want nil", got, err)
+	}
+	fake.mu.Lock()
+	fake.records[rrKey("mail.example.com.", dns.TypeA)] = &dns.A{
+		Hdr: dns.RR_Header{Name: "MAIL.Example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30},
+		A:   net.ParseIP("192.0.2.3"),
+	}
+	fake.mu.Unlock()
+	want := &DNSRecord{Name: "mail", Type: "A", Data: "192.0.2.3", TTL: 30}
+	if got, err := p.GetRecord(ctx, "example.com", "mail", "A"); err != nil || got == nil || *got != *want {
+		t.Errorf("GetRecord() = %+v, %v, want %+v", got, err, want)
+	}
+
+	if err := p.DeleteRecord(ctx, "example.com", "api", "A"); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+	got, err := p.GetRecord(ctx, "example.com", "api", "A")
+	if err != nil {
+		t.Fatalf("GetRecord() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("GetRecord() = %+v after deletion, want nil", got)
+	}
+}
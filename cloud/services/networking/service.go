@@ -27,6 +27,7 @@ import (
 type Service struct {
 	scope *scope.ClusterScope
 	ctx   context.Context
+	dns   DNSProvider
 }
 
 // NewService returns a new service given the digitalocean api client.
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+)
+
+// VPCName returns the name of the VPC managed for the cluster.
+func (s *Service) VPCName(spec *infrav1.DOVPC) string {
+	if spec.Name != "" {
+		return spec.Name
+	}
+	return infrav1.DOSafeName(s.scope.Name()) + "-" + s.scope.UID()
+}
+
+// GetVPC get a VPC by VPC ID.
+func (s *Service) GetVPC(id string) (*godo.VPC, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	vpc, res, err := s.scope.VPCs.Get(s.ctx, id)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return vpc, nil
+}
+
+// GetVPCByName looks up a VPC in the cluster region by name.
+func (s *Service) GetVPCByName(name string) (*godo.VPC, error) {
+	return s.findVPC(func(vpc *godo.VPC) bool {
+		return vpc.Name == name
+	})
+}
+
+// GetClusterVPC returns the VPC the cluster resources are placed in, which is
+// the default VPC of the region if none is configured.
+func (s *Service) GetClusterVPC() (*godo.VPC, error) {
+	if id := s.scope.VPCUUID(); id != "" {
+		return s.GetVPC(id)
+	}
+
+	return s.findVPC(func(vpc *godo.VPC) bool {
+		return vpc.Default
+	})
+}
+
+// findVPC returns the first VPC of the cluster region matching the given function.
+func (s *Service) findVPC(match func(vpc *godo.VPC) bool) (*godo.VPC, error) {
+	opt := &godo.ListOptions{PerPage: 200}
+	for {
+		vpcs, res, err := s.scope.VPCs.List(s.ctx, opt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list VPCs")
+		}
+
+		for _, vpc := range vpcs {
+			if vpc.RegionSlug == s.scope.Region() && match(vpc) {
+				return vpc, nil
+			}
+		}
+
+		if res == nil || res.Links == nil || res.Links.IsLastPage() {
+			return nil, nil
+		}
+
+		page, err := res.Links.CurrentPage()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get current VPC list page")
+		}
+		opt.Page = page + 1
+	}
+}
+
+// CreateVPC creates a VPC.
+func (s *Service) CreateVPC(spec *infrav1.DOVPC) (*godo.VPC, error) {
+	request := &godo.VPCCreateRequest{
+		Name:        s.VPCName(spec),
+		RegionSlug:  s.scope.Region(),
+		Description: spec.Description,
+		IPRange:     spec.IPRange,
+	}
+
+	vpc, _, err := s.scope.VPCs.Create(s.ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpc, nil
+}
+
+// ListVPCMembers returns the resources that are still placed in the VPC.
+func (s *Service) ListVPCMembers(id string) ([]*godo.VPCMember, error) {
+	members, _, err := s.scope.VPCs.ListMembers(s.ctx, id, nil, &godo.ListOptions{PerPage: 200})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// DeleteVPC delete a VPC by ID.
+func (s *Service) DeleteVPC(id string) error {
+	if _, err := s.scope.VPCs.Delete(s.ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/networking/mock_networking"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestService_CreateVPC(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	tests := []struct {
+		name    string
+		spec    *infrav1.DOVPC
+		expect  func(mvpc *mock_networking.MockVPCsServiceMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "name is generated from the cluster",
+			spec: &infrav1.DOVPC{
+				IPRange: "10.10.0.0/16",
+			},
+			expect: func(mvpc *mock_networking.MockVPCsServiceMockRecorder) {
+				mvpc.Create(gomock.Any(), &godo.VPCCreateRequest{
+					Name:       "capdo-test-1234",
+					RegionSlug: "nyc1",
+					IPRange:    "10.10.0.0/16",
+				}).Return(&godo.VPC{ID: "vpc-1"}, nil, nil)
+			},
+		},
+		{
+			name: "name and description from spec",
+			spec: &infrav1.DOVPC{
+				Name:        "my-vpc",
+				Description: "my cluster VPC",
+			},
+			expect: func(mvpc *mock_networking.MockVPCsServiceMockRecorder) {
+				mvpc.Create(gomock.Any(), &godo.VPCCreateRequest{
+					Name:        "my-vpc",
+					RegionSlug:  "nyc1",
+					Description: "my cluster VPC",
+				}).Return(&godo.VPC{ID: "vpc-1"}, nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mvpcs := mock_networking.NewMockVPCsService(mctrl)
+			s := newVPCTestService(t, mvpcs)
+
+			tt.expect(mvpcs.EXPECT())
+			_, err := s.CreateVPC(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.CreateVPC() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetVPCByName(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	tests := []struct {
+		name    string
+		vpcName string
+		expect  func(mvpc *mock_networking.MockVPCsServiceMockRecorder)
+		want    *godo.VPC
+		wantErr bool
+	}{
+		{
+			name:    "found in the cluster region",
+			vpcName: "my-vpc",
+			expect: func(mvpc *mock_networking.MockVPCsServiceMockRecorder) {
+				mvpc.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-1", Name: "my-vpc", RegionSlug: "ams3"},
+					{ID: "vpc-2", Name: "my-vpc", RegionSlug: "nyc1"},
+				}, &godo.Response{}, nil)
+			},
+			want: &godo.VPC{ID: "vpc-2", Name: "my-vpc", RegionSlug: "nyc1"},
+		},
+		{
+			name:    "not found",
+			vpcName: "my-vpc",
+			expect: func(mvpc *mock_networking.MockVPCsServiceMockRecorder) {
+				mvpc.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-1", Name: "other-vpc", RegionSlug: "nyc1"},
+				}, &godo.Response{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mvpcs := mock_networking.NewMockVPCsService(mctrl)
+			s := newVPCTestService(t, mvpcs)
+
+			tt.expect(mvpcs.EXPECT())
+			got, err := s.GetVPCByName(tt.vpcName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.GetVPCByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.GetVPCByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newVPCTestService(t *testing.T, vpcs godo.VPCsService) *Service {
+	t.Helper()
+
+	cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1beta2.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "capdo-test",
+				UID:  types.UID("1234"),
+			},
+		},
+		DOCluster: &infrav1.DOCluster{
+			Spec: infrav1.DOClusterSpec{
+				Region: "nyc1",
+			},
+		},
+		DOClients: scope.DOClients{
+			VPCs: vpcs,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	return NewService(context.TODO(), cscope)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+)
+
+const (
+	projectPurpose     = "Service or API"
+	projectDescription = "Kubernetes clusters managed by Cluster API"
+)
+
+// GetProject get a project by ID.
+func (s *Service) GetProject(id string) (*godo.Project, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	project, res, err := s.scope.Projects.Get(s.ctx, id)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// GetProjectByName returns the project with the given name, or nil if there is none.
+func (s *Service) GetProjectByName(name string) (*godo.Project, error) {
+	opt := &godo.ListOptions{PerPage: 200}
+	for {
+		projects, res, err := s.scope.Projects.List(s.ctx, opt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list projects")
+		}
+
+		for i := range projects {
+			if projects[i].Name == name {
+				return &projects[i], nil
+			}
+		}
+
+		if res == nil || res.Links == nil || res.Links.IsLastPage() {
+			return nil, nil
+		}
+
+		page, err := res.Links.CurrentPage()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get current project list page")
+		}
+		opt.Page = page + 1
+	}
+}
+
+// CreateProject creates a project.
+func (s *Service) CreateProject(name string) (*godo.Project, error) {
+	project, _, err := s.scope.Projects.Create(s.ctx, &godo.CreateProjectRequest{
+		Name:        name,
+		Description: projectDescription,
+		Purpose:     projectPurpose,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create project %q", name)
+	}
+
+	return project, nil
+}
+
+// AssignResources assigns resources to a project, moving them from the
+// project they are currently assigned to. Resources already assigned to the
+// project are left as is.
+func (s *Service) AssignResources(projectID string, resources ...godo.ResourceWithURN) error {
+	if projectID == "" || len(resources) == 0 {
+		return nil
+	}
+
+	urns := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		urns = append(urns, resource.URN())
+	}
+	if _, _, err := s.scope.Projects.AssignResources(s.ctx, projectID, urns...); err != nil {
+		return errors.Wrapf(err, "failed to assign resources to project %s", projectID)
+	}
+
+	return nil
+}
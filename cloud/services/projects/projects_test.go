@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/projects/mock_projects"
+)
+
+var (
+	scheme = runtime.NewScheme()
+)
+
+func init() {
+	utilruntime.Must(infrav1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1beta2.AddToScheme(scheme))
+}
+
+func newService(t *testing.T, mp *mock_projects.MockProjectsService) *Service {
+	t.Helper()
+
+	cscope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster:   &clusterv1beta2.Cluster{},
+		DOCluster: &infrav1.DOCluster{},
+		DOClients: scope.DOClients{
+			Projects: mp,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	return NewService(context.TODO(), cscope)
+}
+
+func TestService_GetProjectByName(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	lastPage := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Prev: "https://api.digitalocean.com/v2/projects?page=1"}}}
+	firstPage := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Next: "https://api.digitalocean.com/v2/projects?page=2", Last: "https://api.digitalocean.com/v2/projects?page=2"}}}
+
+	tests := []struct {
+		name    string
+		expect  func(mp *mock_projects.MockProjectsServiceMockRecorder)
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name: "project on the second page",
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.List(gomock.Any(), &godo.ListOptions{PerPage: 200}).Return([]godo.Project{{ID: "p-1", Name: "default"}}, firstPage, nil)
+				mp.List(gomock.Any(), &godo.ListOptions{Page: 2, PerPage: 200}).Return([]godo.Project{{ID: "p-2", Name: "team"}}, lastPage, nil)
+			},
+			wantID: "p-2",
+		},
+		{
+			name: "project not found",
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.List(gomock.Any(), gomock.Any()).Return([]godo.Project{{ID: "p-1", Name: "default"}}, lastPage, nil)
+			},
+		},
+		{
+			name: "failed listing projects (should return an error)",
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.List(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("error listing projects"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mp := mock_projects.NewMockProjectsService(mctrl)
+			tt.expect(mp.EXPECT())
+
+			got, err := newService(t, mp).GetProjectByName("team")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.GetProjectByName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			gotID := ""
+			if got != nil {
+				gotID = got.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("Service.GetProjectByName() = %q, want %q", gotID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestService_AssignResources(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	tests := []struct {
+		name      string
+		projectID string
+		resources []godo.ResourceWithURN
+		expect    func(mp *mock_projects.MockProjectsServiceMockRecorder)
+		wantErr   bool
+	}{
+		{
+			name:      "default",
+			projectID: "p-1",
+			resources: []godo.ResourceWithURN{godo.Droplet{ID: 1234}, godo.Volume{ID: "vol-1"}, godo.LoadBalancer{ID: "lb-1"}},
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.AssignResources(gomock.Any(), "p-1", "do:droplet:1234", "do:volume:vol-1", "do:loadbalancer:lb-1").Return(nil, nil, nil)
+			},
+		},
+		{
+			name:      "no project",
+			resources: []godo.ResourceWithURN{godo.Droplet{ID: 1234}},
+			expect:    func(_ *mock_projects.MockProjectsServiceMockRecorder) {},
+		},
+		{
+			name:      "failed assigning resources (should return an error)",
+			projectID: "p-1",
+			resources: []godo.ResourceWithURN{godo.Droplet{ID: 1234}},
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.AssignResources(gomock.Any(), "p-1", "do:droplet:1234").Return(nil, nil, errors.New("error assigning resources"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mp := mock_projects.NewMockProjectsService(mctrl)
+			tt.expect(mp.EXPECT())
+
+			if err := newService(t, mp).AssignResources(tt.projectID, tt.resources...); (err != nil) != tt.wantErr {
+				t.Errorf("Service.AssignResources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -47,6 +48,7 @@ import (
 
 	infrav1alpha4 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1alpha4"
 	infrav1beta1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	infrawebhooks "sigs.k8s.io/cluster-api-provider-digitalocean/api/webhooks"
 	capdocontroller "sigs.k8s.io/cluster-api-provider-digitalocean/internal/controller"
 	dnsutil "sigs.k8s.io/cluster-api-provider-digitalocean/util/dns"
@@ -66,6 +68,7 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = infrav1alpha4.AddToScheme(scheme)
 	_ = infrav1beta1.AddToScheme(scheme)
+	_ = infrav1beta2.AddToScheme(scheme)
 	_ = clusterv1beta2.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
@@ -97,6 +100,11 @@ var (
 	tlsOpts                        []func(*tls.Config)
 	maxConcurrentReconcilesCluster int
 	maxConcurrentReconcilesMachine int
+	machineResyncInterval          time.Duration
+	dnsResolverMode                string
+	dnsOverHTTPSEndpoint           string
+	dnsNameservers                 []string
+	dnsPropagationQuorum           int
 )
 
 func initFlags(fs *pflag.FlagSet) {
@@ -125,6 +133,11 @@ func initFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
 	fs.IntVar(&maxConcurrentReconcilesCluster, "max-concurrent-reconciles-cluster", 2, "Maximum concurrent reconciles for clusters")
 	fs.IntVar(&maxConcurrentReconcilesMachine, "max-concurrent-reconciles-machine", 5, "Maximum concurrent reconciles for machines")
+	fs.DurationVar(&machineResyncInterval, "machine-resync-interval", 5*time.Minute, "Interval at which the droplets of ready machines are observed again to detect out of band changes (e.g. 5m). Set to 0 to disable.")
+	fs.StringVar(&dnsResolverMode, "dns-resolver", "system", "Resolver used to check DNS record propagation, one of system (DNS over UDP/TCP using /etc/resolv.conf) or doh (DNS over HTTPS). With doh, propagation is checked against the DNS over HTTPS endpoint only, which may cache negative answers.")
+	fs.StringVar(&dnsOverHTTPSEndpoint, "dns-over-https-endpoint", "", "URL of the DNS over HTTPS (RFC 8484) endpoint used by the doh resolver (e.g. https://cloudflare-dns.com/dns-query).")
+	fs.StringSliceVar(&dnsNameservers, "dns-nameservers", nil, "Nameservers queried to check DNS record propagation. If unspecified, all the authoritative nameservers of the zone are queried.")
+	fs.IntVar(&dnsPropagationQuorum, "dns-propagation-quorum", 0, "Number of nameservers which must answer with a DNS record for it to be considered propagated. If unspecified, all of them must answer.")
 }
 
 // Add RBAC for the authorized diagnostics endpoint.
@@ -274,13 +287,31 @@ func main() {
 	// Initialize event recorder.
 	record.InitFromRecorder(mgr.GetEventRecorderFor("digitalocean-controller"))
 
-	dnsresolver, err := dnsresolver.NewDNSResolver()
+	var dnsResolver dnsresolver.DNSResolver
+	switch dnsResolverMode {
+	case "system":
+		dnsResolver, err = dnsresolver.NewDNSResolver()
+	case "doh":
+		// The DNS over HTTPS endpoint answers all the queries itself, so
+		// propagation cannot be checked against specific nameservers.
+		if len(dnsNameservers) > 0 || dnsPropagationQuorum > 0 {
+			err = fmt.Errorf("--dns-nameservers and --dns-propagation-quorum cannot be used with the doh DNS resolver")
+			break
+		}
+		dnsResolver, err = dnsresolver.NewDoHResolver(dnsOverHTTPSEndpoint)
+	default:
+		err = fmt.Errorf("unknown DNS resolver %q", dnsResolverMode)
+	}
 	if err != nil {
 		setupLog.Error(err, "unable to create dns resolver")
 		os.Exit(1)
 	}
 
-	dnsutil.InitFromDNSResolver(dnsresolver)
+	dnsutil.InitFromDNSResolver(dnsResolver)
+	dnsutil.InitPropagationConfig(dnsutil.PropagationConfig{
+		Nameservers: dnsNameservers,
+		Quorum:      dnsPropagationQuorum,
+	})
 
 	if err = (&capdocontroller.DOClusterReconciler{
 		Client:           mgr.GetClient(),
@@ -297,12 +328,23 @@ func main() {
 		Recorder:           mgr.GetEventRecorderFor("domachine-controller"),
 		ReconcileTimeout:   reconcileTimeout,
 		EnableAntiAffinity: enableAntiAffinity,
+		ResyncInterval:     machineResyncInterval,
 	}).SetupWithManager(ctx, mgr, controller.Options{
 		MaxConcurrentReconciles: maxConcurrentReconcilesMachine,
 	}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DOMachine")
 		os.Exit(1)
 	}
+	if err = (&capdocontroller.DORemediationReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("doremediation-controller"),
+		ReconcileTimeout: reconcileTimeout,
+	}).SetupWithManager(ctx, mgr, controller.Options{
+		MaxConcurrentReconciles: maxConcurrentReconcilesMachine,
+	}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DORemediation")
+		os.Exit(1)
+	}
 
 	if err := (&infrawebhooks.DOClusterWebhook{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "DOCluster")
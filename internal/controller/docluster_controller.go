@@ -17,58 +17,146 @@ limitations under the License.
 package controller
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"net"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/digitalocean/godo"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/record"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/networking"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/projects"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/util/apiserver"
 	dnsutil "sigs.k8s.io/cluster-api-provider-digitalocean/util/dns"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/util/reconciler"
-	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// reservedIPFailoverInterval is the interval at which the health of the
+// control plane droplet holding the reserved IP endpoint is checked.
+const reservedIPFailoverInterval = 30 * time.Second
+
 // DOClusterReconciler reconciles a DOCluster object.
 type DOClusterReconciler struct {
 	client.Client
 	Recorder         record.EventRecorder
 	ReconcileTimeout time.Duration
+	// APIServerHealthCheck checks the health of the control plane droplets when
+	// the reserved IP endpoint is used. Defaults to apiserver.CheckHealth.
+	APIServerHealthCheck apiserver.HealthChecker
 }
 
 func (r *DOClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
-		For(&infrav1.DOCluster{}).
+		For(&infrav1.DOCluster{}, builder.WithPredicates(doClusterChanged())).
 		WithEventFilter(predicates.ResourceNotPaused(mgr.GetScheme(), ctrl.LoggerFrom(ctx))). // don't queue reconcile if resource is paused
 		Watches(
 			&clusterv1beta2.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(util.ClusterToInfrastructureMapFunc(ctx, infrav1.GroupVersion.WithKind("DOCluster"), mgr.GetClient(), &infrav1.DOCluster{})),
 			builder.WithPredicates(predicates.ClusterUnpaused(mgr.GetScheme(), ctrl.LoggerFrom(ctx))),
 		).
+		Watches(
+			&infrav1.DOMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.DOMachineToDOCluster(ctx)),
+		).
 		Complete(r)
 }
 
+// doClusterChanged filters out the DOCluster updates which only change its
+// status. The controller patches the status on every reconcile, so these
+// updates would immediately trigger a new reconcile and defeat the requeue
+// intervals, e.g. of the DNS propagation checks.
+func doClusterChanged() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.AnnotationChangedPredicate{},
+		predicate.LabelChangedPredicate{},
+		ownerReferencesChanged(),
+	)
+}
+
+// ownerReferencesChanged passes updates changing the owner references of an
+// object, so that a DOCluster is reconciled once its Cluster owns it.
+func ownerReferencesChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			return !reflect.DeepEqual(e.ObjectOld.GetOwnerReferences(), e.ObjectNew.GetOwnerReferences())
+		},
+	}
+}
+
+// DOMachineToDOCluster maps control plane DOMachines to the DOCluster of their
+// cluster, so that DNS records pointing to the machines are kept up to date.
+func (r *DOClusterReconciler) DOMachineToDOCluster(ctx context.Context) handler.MapFunc {
+	log := ctrl.LoggerFrom(ctx)
+	return func(ctx context.Context, o client.Object) []ctrl.Request {
+		m, ok := o.(*infrav1.DOMachine)
+		if !ok {
+			log.Error(errors.Errorf("expected a DOMachine but got a %T", o), "failed to get DOCluster for DOMachine")
+			return nil
+		}
+		if _, ok := m.Labels[clusterv1beta2.MachineControlPlaneLabel]; !ok {
+			return nil
+		}
+
+		cluster, err := util.GetClusterFromMetadata(ctx, r.Client, m.ObjectMeta)
+		switch {
+		case apierrors.IsNotFound(err) || cluster == nil:
+			return nil
+		case err != nil:
+			log.Error(err, "failed to get owning cluster")
+			return nil
+		}
+
+		ref := cluster.Spec.InfrastructureRef
+		if ref.Name == "" || ref.Kind != "DOCluster" {
+			return nil
+		}
+		return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: cluster.Namespace, Name: ref.Name}}}
+	}
+}
+
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=doclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=doclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=doclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=domachines,verbs=get;list;watch
 
 func (r *DOClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
@@ -118,6 +206,11 @@ func (r *DOClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}()
 
+	// Handle clusters whose infrastructure is provisioned by something else.
+	if annotations.IsExternallyManaged(doCluster) {
+		return r.reconcileExternallyManaged(clusterScope)
+	}
+
 	// Handle deleted clusters
 	if !doCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, clusterScope)
@@ -126,6 +219,48 @@ func (r *DOClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return r.reconcile(ctx, clusterScope)
 }
 
+// reconcileExternallyManaged handles a DOCluster whose infrastructure is
+// provisioned by something else. No DigitalOcean resources are created or
+// deleted, only the user supplied control plane endpoint is validated.
+func (r *DOClusterReconciler) reconcileExternallyManaged(clusterScope *scope.ClusterScope) (reconcile.Result, error) {
+	docluster := clusterScope.DOCluster
+
+	if !docluster.DeletionTimestamp.IsZero() {
+		// A finalizer added before the DOCluster became externally managed
+		// must not block its deletion.
+		controllerutil.RemoveFinalizer(docluster, infrav1.ClusterFinalizer)
+		return reconcile.Result{}, nil
+	}
+
+	clusterScope.Info("DOCluster is externally managed, only validating the control plane endpoint")
+	if err := validateControlPlaneEndpoint(docluster.Spec.ControlPlaneEndpoint); err != nil {
+		clusterScope.Info("Waiting for a valid control plane endpoint", "reason", err.Error())
+		r.Recorder.Eventf(docluster, corev1.EventTypeWarning, "InvalidControlPlaneEndpoint", "Invalid control plane endpoint: %v", err)
+		return reconcile.Result{}, nil
+	}
+
+	clusterScope.SetReady()
+	return reconcile.Result{}, nil
+}
+
+// validateControlPlaneEndpoint returns an error if the endpoint is not a
+// valid IP address or DNS name and port.
+func validateControlPlaneEndpoint(endpoint clusterv1beta2.APIEndpoint) error {
+	if !endpoint.IsValid() {
+		return errors.New("host and port must be set")
+	}
+	if net.ParseIP(endpoint.Host) == nil {
+		if errs := validation.IsDNS1123Subdomain(endpoint.Host); len(errs) > 0 {
+			return errors.Errorf("host %q is neither an IP address nor a DNS name: %s", endpoint.Host, strings.Join(errs, ", "))
+		}
+	}
+	if endpoint.Port > 65535 {
+		return errors.Errorf("port %d is out of range", endpoint.Port)
+	}
+
+	return nil
+}
+
 func (r *DOClusterReconciler) reconcile(ctx context.Context, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
 	clusterScope.Info("Reconciling DOCluster")
 	docluster := clusterScope.DOCluster
@@ -133,67 +268,803 @@ func (r *DOClusterReconciler) reconcile(ctx context.Context, clusterScope *scope
 	controllerutil.AddFinalizer(docluster, infrav1.ClusterFinalizer)
 
 	networkingsvc := networking.NewService(ctx, clusterScope)
+
+	if err := r.reconcileVPC(clusterScope, networkingsvc); err != nil {
+		setCondition(docluster, infrav1.VPCReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile VPC for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	projectsvc := projects.NewService(ctx, clusterScope)
+	if err := r.reconcileProject(clusterScope, projectsvc); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile project for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	// The bastion is not required for the cluster to be ready, so only
+	// requeue for it once everything else has been reconciled.
+	bastion, bastionResult, err := r.reconcileBastion(ctx, clusterScope, networkingsvc)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile bastion for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
 	apiServerLoadbalancer := clusterScope.APIServerLoadbalancers()
 	apiServerLoadbalancer.ApplyDefault()
 
-	apiServerLoadbalancerRef := clusterScope.APIServerLoadbalancersRef()
-	lbUUID := apiServerLoadbalancerRef.ResourceID
+	var lbIDs []string
+	var endpoints []infrav1.DOAPIServerEndpointStatus
+	var taggedResources []computes.TaggedResource
+	if bastion != nil {
+		taggedResources = append(taggedResources, taggedDroplet(clusterScope, bastion, infrav1.BastionRoleTagValue))
+	}
+	if clusterScope.APIServerEndpointType() == infrav1.DOAPIServerEndpointTypeReservedIP {
+		reservedIP, err := r.reconcileReservedIP(clusterScope, networkingsvc)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile reserved IP for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+		}
+		endpoints = append(endpoints, infrav1.DOAPIServerEndpointStatus{
+			Visibility: infrav1.DOEndpointVisibilityPublic,
+			Host:       reservedIP.IP,
+			Port:       apiServerLoadbalancer.Port,
+		})
+		conditions.Delete(docluster, infrav1.LoadBalancerReadyCondition)
+	} else {
+		loadbalancer, err := r.reconcileLoadBalancer(clusterScope, networkingsvc, apiServerLoadbalancer,
+			clusterScope.APIServerLoadbalancersRef(), networkingsvc.LoadBalancerRequest(apiServerLoadbalancer))
+		if err != nil {
+			setCondition(docluster, infrav1.LoadBalancerReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
+			return reconcile.Result{}, err
+		}
+		visibility := infrav1.DOEndpointVisibilityPublic
+		if apiServerLoadbalancer.Network == infrav1.LBNetworkInternal {
+			visibility = infrav1.DOEndpointVisibilityPrivate
+		}
+		lbIDs = append(lbIDs, loadbalancer.ID)
+		if clusterScope.APIServerLoadbalancersRef().Ownership != infrav1.DOResourceUnmanaged {
+			taggedResources = append(taggedResources, taggedLoadBalancer(clusterScope, loadbalancer))
+		}
+		endpoints = append(endpoints, infrav1.DOAPIServerEndpointStatus{
+			Visibility: visibility,
+			Host:       loadbalancer.IP,
+			HostIPv6:   loadbalancer.IPv6,
+			Port:       apiServerLoadbalancer.Port,
+		})
 
-	if apiServerLoadbalancer.ResourceID != "" {
-		lbUUID = apiServerLoadbalancer.ResourceID
+		if publicLoadbalancer := clusterScope.APIServerPublicLoadbalancer(); publicLoadbalancer != nil {
+			publicLoadbalancer.ApplyDefault()
+			loadbalancer, err := r.reconcileLoadBalancer(clusterScope, networkingsvc, publicLoadbalancer,
+				clusterScope.APIServerPublicLoadbalancerRef(), networkingsvc.PublicLoadBalancerRequest(publicLoadbalancer))
+			if err != nil {
+				setCondition(docluster, infrav1.LoadBalancerReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
+				return reconcile.Result{}, err
+			}
+			lbIDs = append(lbIDs, loadbalancer.ID)
+			if clusterScope.APIServerPublicLoadbalancerRef().Ownership != infrav1.DOResourceUnmanaged {
+				taggedResources = append(taggedResources, taggedLoadBalancer(clusterScope, loadbalancer))
+			}
+			endpoints = append(endpoints, infrav1.DOAPIServerEndpointStatus{
+				Visibility: infrav1.DOEndpointVisibilityPublic,
+				Host:       loadbalancer.IP,
+				HostIPv6:   loadbalancer.IPv6,
+				Port:       publicLoadbalancer.Port,
+			})
+		} else if ref := clusterScope.APIServerPublicLoadbalancerRef(); ref.ResourceID != "" {
+			if err := r.deleteLoadBalancer(clusterScope, networkingsvc, ref); err != nil {
+				return reconcile.Result{}, errors.Wrapf(err, "error deleting public load balancer for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+			}
+			*ref = infrav1.DOResourceReference{}
+		}
 	}
 
-	loadbalancer, err := networkingsvc.GetLoadBalancer(lbUUID)
+	if err := r.reconcileFirewalls(clusterScope, networkingsvc, lbIDs); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile firewalls for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	// Tags are only set on the load balancers and the bastion when they are
+	// created, so changes to the additional tags are applied here.
+	if err := computes.NewService(ctx, clusterScope).ReconcileClusterTags(taggedResources...); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile tags for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	if err := projectsvc.AssignResources(clusterScope.ProjectID(), projectResources(clusterScope)...); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile project resources for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Host == "" {
+			clusterScope.Info("Waiting on API server Global IP Address")
+			if len(lbIDs) > 0 {
+				setCondition(docluster, infrav1.LoadBalancerReadyCondition, metav1.ConditionFalse, infrav1.LoadBalancerWaitingForIPReason,
+					fmt.Sprintf("Waiting for the %s load balancer to get an IP address", strings.ToLower(string(endpoint.Visibility))))
+			}
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+	if len(lbIDs) > 0 {
+		setCondition(docluster, infrav1.LoadBalancerReadyCondition, metav1.ConditionTrue, infrav1.ReadyReason, "")
+	}
+
+	primary := primaryAPIServerEndpoint(endpoints, clusterScope.APIServerPrimaryEndpoint())
+	clusterScope.SetAPIServerEndpoints(endpoints)
+	endpointIP := primary.Host
+
+	if len(lbIDs) > 0 {
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerReady", "LoadBalancer got an IP Address - %s", endpointIP)
+	}
+
+	controlPlaneEndpoint, result, err := r.reconcileControlPlaneDNS(clusterScope, networkingsvc, endpointIP, primary.HostIPv6)
 	if err != nil {
+		setDNSRecordReadyConditionFromError(docluster, err)
 		return reconcile.Result{}, err
 	}
-	if loadbalancer == nil {
-		loadbalancer, err = networkingsvc.CreateLoadBalancer(apiServerLoadbalancer)
+	if !result.IsZero() {
+		return result, nil
+	}
+
+	clusterScope.SetControlPlaneEndpoint(clusterv1beta2.APIEndpoint{
+		Host: controlPlaneEndpoint,
+		Port: primary.Port,
+	})
+
+	clusterScope.Info("Set DOCluster status to ready")
+	clusterScope.SetReady()
+	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "DOClusterReady", "DOCluster %s - has ready status", clusterScope.Name())
+
+	if err := r.reconcileDNSRecords(ctx, clusterScope, networkingsvc, primary); err != nil {
+		setDNSRecordReadyConditionFromError(docluster, err)
+		return reconcile.Result{}, errors.Wrap(err, "failed to reconcile additional DNS records")
+	}
+
+	if clusterScope.APIServerEndpointType() == infrav1.DOAPIServerEndpointTypeReservedIP {
+		if err := r.reconcileReservedIPFailover(ctx, clusterScope, networkingsvc); err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile reserved IP assignment for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+		}
+		// Keep checking the health of the reserved IP holder.
+		return util.LowestNonZeroResult(bastionResult, reconcile.Result{RequeueAfter: reservedIPFailoverInterval}), nil
+	}
+
+	return bastionResult, nil
+}
+
+// primaryAPIServerEndpoint marks the endpoint with the given visibility as
+// primary, falling back to the first endpoint, and returns it.
+func primaryAPIServerEndpoint(endpoints []infrav1.DOAPIServerEndpointStatus, visibility infrav1.DOEndpointVisibility) *infrav1.DOAPIServerEndpointStatus {
+	primary := &endpoints[0]
+	for i := range endpoints {
+		if endpoints[i].Visibility == visibility {
+			primary = &endpoints[i]
+			break
+		}
+	}
+	primary.Primary = true
+	return primary
+}
+
+// reconcileLoadBalancer ensures an API server load balancer exists and
+// matches the request, and records it in the given reference. A load balancer
+// referenced in the spec but not recorded yet is adopted rather than created.
+func (r *DOClusterReconciler) reconcileLoadBalancer(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, spec *infrav1.DOLoadBalancer, ref *infrav1.DOResourceReference, request *godo.LoadBalancerRequest) (*godo.LoadBalancer, error) {
+	docluster := clusterScope.DOCluster
+	lbUUID := ref.ResourceID
+
+	// The ID of a created load balancer is also stored in the spec, so only a
+	// different ID means the user asked to adopt a pre-existing one.
+	adopting := ref.Ownership == "" && spec.ResourceID != "" && spec.ResourceID != ref.ResourceID
+	if spec.ResourceID != "" {
+		lbUUID = spec.ResourceID
+	}
+
+	loadbalancer, err := networkingsvc.GetLoadBalancer(lbUUID)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Ownership == "" && !adopting && loadbalancer != nil {
+		ref.Ownership = loadBalancerOwnership(loadbalancer, request.Name)
+	}
+
+	switch {
+	case loadbalancer == nil && (adopting || ref.Ownership == infrav1.DOResourceUnmanaged):
+		return nil, errors.Errorf("load balancer %s referenced by DOCluster %s/%s not found", lbUUID, docluster.Namespace, docluster.Name)
+	case loadbalancer == nil:
+		loadbalancer, err = networkingsvc.CreateLoadBalancer(request)
 		if err != nil {
-			return reconcile.Result{}, errors.Wrapf(err, "failed to create load balancers for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+			return nil, errors.Wrapf(err, "failed to create load balancers for DOCluster %s/%s", docluster.Namespace, docluster.Name)
 		}
 
 		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerCreated", "Created new load balancers - %s", loadbalancer.Name)
+		ref.Ownership = infrav1.DOResourceOwned
+	case adopting:
+		if err := networkingsvc.ValidateAdoptedLoadBalancer(loadbalancer, request); err != nil {
+			r.Recorder.Eventf(docluster, corev1.EventTypeWarning, "LoadBalancerAdoptionFailed", "Unable to adopt load balancer %s: %v", loadbalancer.Name, err)
+			return nil, err
+		}
+		if loadbalancer.Tag != request.Tag {
+			r.Recorder.Eventf(docluster, corev1.EventTypeWarning, "LoadBalancerNotTargetingControlPlane",
+				"Adopted load balancer %s forwards to droplets tagged %q instead of %q", loadbalancer.Name, loadbalancer.Tag, request.Tag)
+		}
+
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerAdopted", "Adopted unmanaged load balancer - %s", loadbalancer.Name)
+		ref.Ownership = infrav1.DOResourceUnmanaged
+	case ref.Ownership == infrav1.DOResourceUnmanaged:
+		// Unmanaged load balancers are never modified beyond their tag.
+	case loadbalancer.Status == string(infrav1.DOResourceStatusRunning):
+		// The spec is the source of truth, so revert any change made out-of-band
+		// and apply the changes made to the spec since the LB was created.
+		if drift := networking.LoadBalancerDrift(loadbalancer, request); len(drift) > 0 {
+			clusterScope.Info("Load balancer drifted from the desired state, updating", "loadbalancer-id", loadbalancer.ID, "drift", drift)
+			loadbalancer, err = networkingsvc.UpdateLoadBalancer(loadbalancer, request)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to update load balancer for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+			}
+			for _, d := range drift {
+				r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerUpdated", "Corrected load balancer %s - %s", loadbalancer.Name, d)
+			}
+		}
 	}
 
-	apiServerLoadbalancerRef.ResourceID = loadbalancer.ID
-	apiServerLoadbalancerRef.ResourceStatus = infrav1.DOResourceStatus(loadbalancer.Status)
-	apiServerLoadbalancer.ResourceID = loadbalancer.ID
+	if ref.Ownership == infrav1.DOResourceUnmanaged && !slices.Contains(loadbalancer.Tags, networkingsvc.LoadBalancerTag()) {
+		if err := networkingsvc.TagLoadBalancer(loadbalancer.ID); err != nil {
+			return nil, err
+		}
+	}
 
-	if apiServerLoadbalancerRef.ResourceStatus != infrav1.DOResourceStatusRunning && loadbalancer.IP == "" {
-		clusterScope.Info("Waiting on API server Global IP Address")
-		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+	ref.ResourceID = loadbalancer.ID
+	ref.ResourceStatus = infrav1.DOResourceStatus(loadbalancer.Status)
+	spec.ResourceID = loadbalancer.ID
+
+	return loadbalancer, nil
+}
+
+// deleteLoadBalancer deletes the API server load balancer with the given
+// reference, or only releases it if it is unmanaged.
+func (r *DOClusterReconciler) deleteLoadBalancer(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, ref *infrav1.DOResourceReference) error {
+	docluster := clusterScope.DOCluster
+	loadbalancer, err := networkingsvc.GetLoadBalancer(ref.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	if loadbalancer == nil {
+		clusterScope.V(2).Info("Unable to locate load balancer")
+		r.Recorder.Eventf(docluster, corev1.EventTypeWarning, "NoLoadBalancerFound", "Unable to find matching load balancer")
+		return nil
+	}
+
+	ownership := ref.Ownership
+	if ownership == "" {
+		ownership = loadBalancerOwnership(loadbalancer, networkingsvc.LoadBalancerName(), networkingsvc.PublicLoadBalancerName())
+	}
+	if ownership == infrav1.DOResourceUnmanaged {
+		if err := networkingsvc.UntagLoadBalancer(loadbalancer.ID); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerReleased", "Released unmanaged load balancer - %s", loadbalancer.Name)
+		return nil
+	}
+
+	if err := networkingsvc.DeleteLoadBalancer(loadbalancer.ID); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerDeleted", "Deleted an LoadBalancer - %s", loadbalancer.Name)
+	return nil
+}
+
+// loadBalancerOwnership returns the ownership of a load balancer recorded
+// before ownership was tracked. The provider names the load balancers it
+// creates after the cluster, so any other load balancer was supplied by the
+// user through its ID.
+func loadBalancerOwnership(loadbalancer *godo.LoadBalancer, names ...string) infrav1.DOResourceOwnership {
+	if slices.Contains(names, loadbalancer.Name) {
+		return infrav1.DOResourceOwned
+	}
+	return infrav1.DOResourceUnmanaged
+}
+
+// reconcileReservedIP ensures the reserved IP used as control plane endpoint
+// exists and records it in the DOCluster status.
+func (r *DOClusterReconciler) reconcileReservedIP(clusterScope *scope.ClusterScope, networkingsvc *networking.Service) (*godo.ReservedIP, error) {
+	docluster := clusterScope.DOCluster
+	reservedIPRef := clusterScope.APIServerReservedIP()
+
+	reservedIP, err := networkingsvc.GetReservedIP(reservedIPRef.IP)
+	if err != nil {
+		return nil, err
+	}
+	if reservedIP == nil {
+		// The control plane endpoint can't change once set, so never
+		// silently replace a reserved IP which has been released.
+		if docluster.Spec.ControlPlaneEndpoint.Host != "" && reservedIPRef.IP != "" {
+			return nil, errors.Errorf("reserved IP %s used as control plane endpoint no longer exists", reservedIPRef.IP)
+		}
+
+		reservedIP, err = networkingsvc.CreateReservedIP()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create reserved IP")
+		}
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "ReservedIPCreated", "Created new reserved IP - %s", reservedIP.IP)
+
+		// Reserved IPs can't be tagged, so the status is the only record of
+		// the one created for this cluster: persist it right away, or release
+		// it, so that a later failure of this reconcile doesn't leak it.
+		reservedIPRef.IP = reservedIP.IP
+		reservedIPRef.DropletID = ""
+		if err := clusterScope.PatchObject(); err != nil {
+			reservedIPRef.IP = ""
+			if derr := networkingsvc.DeleteReservedIP(reservedIP.IP); derr != nil {
+				return nil, errors.Wrapf(kerrors.NewAggregate([]error{err, derr}), "failed to record reserved IP %s", reservedIP.IP)
+			}
+			return nil, errors.Wrapf(err, "failed to record reserved IP %s", reservedIP.IP)
+		}
+	}
+
+	reservedIPRef.IP = reservedIP.IP
+	reservedIPRef.DropletID = ""
+	if reservedIP.Droplet != nil {
+		reservedIPRef.DropletID = strconv.Itoa(reservedIP.Droplet.ID)
+	}
+
+	return reservedIP, nil
+}
+
+// reconcileReservedIPFailover keeps the reserved IP assigned to a healthy
+// control plane droplet. The reserved IP is moved whenever the droplet
+// holding it is deleted or its API server is unhealthy and another control
+// plane droplet is healthy. Until a control plane droplet is healthy, e.g.
+// while the first one is initialized, it is assigned to any active one.
+func (r *DOClusterReconciler) reconcileReservedIPFailover(ctx context.Context, clusterScope *scope.ClusterScope, networkingsvc *networking.Service) error {
+	docluster := clusterScope.DOCluster
+	reservedIPRef := clusterScope.APIServerReservedIP()
+	port := clusterScope.APIServerLoadbalancers().Port
+	checkHealth := r.APIServerHealthCheck
+	if checkHealth == nil {
+		checkHealth = apiserver.CheckHealth
+	}
+
+	droplets, err := computes.NewService(ctx, clusterScope).ListControlPlaneDroplets()
+	if err != nil {
+		return err
+	}
+
+	var candidates []godo.Droplet
+	for _, droplet := range droplets {
+		if droplet.Status == string(infrav1.DOResourceStatusRunning) {
+			candidates = append(candidates, droplet)
+		}
+	}
+	if len(candidates) == 0 {
+		clusterScope.V(2).Info("No active control plane droplet to assign the reserved IP to")
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].ID < candidates[j].ID })
+
+	healthy := func(droplet *godo.Droplet) bool {
+		ip, err := droplet.PublicIPv4()
+		if err != nil || ip == "" {
+			return false
+		}
+		return checkHealth(ctx, ip, port)
+	}
+
+	var holder *godo.Droplet
+	for i := range candidates {
+		if strconv.Itoa(candidates[i].ID) == reservedIPRef.DropletID {
+			holder = &candidates[i]
+			break
+		}
+	}
+	if holder != nil && healthy(holder) {
+		return nil
+	}
+
+	var target *godo.Droplet
+	for i := range candidates {
+		if &candidates[i] != holder && healthy(&candidates[i]) {
+			target = &candidates[i]
+			break
+		}
+	}
+	if target == nil {
+		if holder != nil {
+			clusterScope.Info("Reserved IP holder is unhealthy but no other control plane droplet is healthy", "droplet-id", holder.ID)
+			return nil
+		}
+		target = &candidates[0]
+	}
+
+	if err := networkingsvc.AssignReservedIP(reservedIPRef.IP, target.ID); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "ReservedIPAssigned", "Assigned reserved IP %s to control plane droplet %s", reservedIPRef.IP, target.Name)
+	reservedIPRef.DropletID = strconv.Itoa(target.ID)
+
+	return nil
+}
+
+// reconcileVPC ensures the VPC described in the DOCluster spec exists when
+// it is managed by the cluster and records it in the DOCluster status.
+func (r *DOClusterReconciler) reconcileVPC(clusterScope *scope.ClusterScope, networkingsvc *networking.Service) error {
+	vpcSpec := clusterScope.VPC()
+	if !vpcSpec.IsManaged() {
+		setCondition(clusterScope.DOCluster, infrav1.VPCReadyCondition, metav1.ConditionTrue, infrav1.VPCNotManagedReason, "The VPC is not managed by the provider")
+		return nil
+	}
+
+	docluster := clusterScope.DOCluster
+	vpcRef := clusterScope.VPCRef()
+
+	vpc, err := networkingsvc.GetVPC(vpcRef.ResourceID)
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		// The VPC may have been created by a previous reconcile whose
+		// status update got lost, so look it up by name before creating it.
+		// A name set in the spec may also designate a VPC of the user.
+		vpc, err = networkingsvc.GetVPCByName(networkingsvc.VPCName(vpcSpec))
+		if err != nil {
+			return err
+		}
+		if vpc != nil {
+			vpcRef.Ownership = vpcOwnership(docluster, vpcSpec, vpc)
+			if vpcRef.Ownership == infrav1.DOResourceUnmanaged {
+				r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "VPCAdopted", "Adopted existing VPC %s, it will not be deleted with the cluster", vpc.Name)
+			}
+		}
+	}
+	if vpc == nil {
+		clusterScope.Info("Creating VPC")
+		vpc, err = networkingsvc.CreateVPC(vpcSpec)
+		if err != nil {
+			return errors.Wrap(err, "failed to create VPC")
+		}
+
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "VPCCreated", "Created new VPC - %s", vpc.Name)
+		vpcRef.Ownership = infrav1.DOResourceOwned
+	}
+
+	vpcRef.ResourceID = vpc.ID
+	vpcRef.IPRange = vpc.IPRange
+	// VPCs recorded before their ownership was tracked.
+	if vpcRef.Ownership == "" {
+		vpcRef.Ownership = vpcOwnership(docluster, vpcSpec, vpc)
+	}
+	setCondition(docluster, infrav1.VPCReadyCondition, metav1.ConditionTrue, infrav1.ReadyReason, "")
+	return nil
+}
+
+// vpcOwnership tells whether a VPC found by name was created for the cluster.
+// The generated name is unique to the cluster, but a name set in the spec may
+// designate a VPC which existed before the cluster: such a VPC is adopted and
+// must never be deleted.
+func vpcOwnership(docluster *infrav1.DOCluster, spec *infrav1.DOVPC, vpc *godo.VPC) infrav1.DOResourceOwnership {
+	if spec.Name != "" && vpc.CreatedAt.Before(docluster.CreationTimestamp.Time) {
+		return infrav1.DOResourceUnmanaged
+	}
+	return infrav1.DOResourceOwned
+}
+
+// reconcileProject looks up the project the cluster resources are assigned
+// to, creating it if requested, and records its ID.
+func (r *DOClusterReconciler) reconcileProject(clusterScope *scope.ClusterScope, projectsvc *projects.Service) error {
+	spec := clusterScope.Project()
+	if spec == nil {
+		clusterScope.SetProjectID("")
+		return nil
+	}
+
+	docluster := clusterScope.DOCluster
+	id := spec.ID
+	if id == "" {
+		id = clusterScope.ProjectID()
+	}
+	project, err := projectsvc.GetProject(id)
+	if err != nil {
+		return err
+	}
+	// The project recorded for a name may have been renamed or replaced since.
+	if project != nil && spec.ID == "" && project.Name != spec.Name {
+		project = nil
+	}
+	if project == nil && spec.Name != "" {
+		project, err = projectsvc.GetProjectByName(spec.Name)
+		if err != nil {
+			return err
+		}
+		if project == nil && spec.Create {
+			clusterScope.Info("Creating project", "name", spec.Name)
+			project, err = projectsvc.CreateProject(spec.Name)
+			if err != nil {
+				return err
+			}
+
+			r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "ProjectCreated", "Created new project - %s", project.Name)
+		}
+	}
+	if project == nil {
+		return errors.Errorf("project %q referenced by DOCluster %s/%s not found", cmp.Or(spec.ID, spec.Name), docluster.Namespace, docluster.Name)
+	}
+
+	clusterScope.SetProjectID(project.ID)
+	return nil
+}
+
+// projectResources returns the resources created for the cluster by the
+// DOCluster controller which belong to the cluster project. Adopted load
+// balancers are left in their project.
+func projectResources(clusterScope *scope.ClusterScope) []godo.ResourceWithURN {
+	var resources []godo.ResourceWithURN
+	for _, ref := range []*infrav1.DOResourceReference{clusterScope.APIServerLoadbalancersRef(), clusterScope.APIServerPublicLoadbalancerRef()} {
+		if ref.ResourceID != "" && ref.Ownership != infrav1.DOResourceUnmanaged {
+			resources = append(resources, godo.LoadBalancer{ID: ref.ResourceID})
+		}
+	}
+	if ip := clusterScope.APIServerReservedIP().IP; ip != "" {
+		resources = append(resources, godo.ReservedIP{IP: ip})
+	}
+	if bastion := clusterScope.DOCluster.Status.Bastion; bastion != nil && bastion.ResourceID != "" {
+		if id, err := strconv.Atoi(bastion.ResourceID); err == nil {
+			resources = append(resources, godo.Droplet{ID: id})
+		}
+	}
+	return resources
+}
+
+// taggedLoadBalancer returns a load balancer created by the provider as a
+// resource carrying the additional tags of the cluster.
+func taggedLoadBalancer(clusterScope *scope.ClusterScope, lb *godo.LoadBalancer) computes.TaggedResource {
+	return computes.TaggedResource{
+		Resource: godo.Resource{ID: lb.ID, Type: godo.LoadBalancerResourceType},
+		Tags:     lb.Tags,
+		BaseTags: infrav1.BuildTags(infrav1.BuildTagParams{
+			ClusterName: infrav1.DOSafeName(clusterScope.Name()),
+			ClusterUID:  clusterScope.UID(),
+			Name:        lb.Name,
+			Role:        infrav1.APIServerRoleTagValue,
+		}),
+	}
+}
+
+// taggedDroplet returns a droplet of the cluster as a resource carrying the
+// additional tags of the cluster.
+func taggedDroplet(clusterScope *scope.ClusterScope, droplet *godo.Droplet, role string) computes.TaggedResource {
+	return computes.TaggedResource{
+		Resource: godo.Resource{ID: strconv.Itoa(droplet.ID), Type: godo.DropletResourceType},
+		Tags:     droplet.Tags,
+		BaseTags: infrav1.BuildTags(infrav1.BuildTagParams{
+			ClusterName: infrav1.DOSafeName(clusterScope.Name()),
+			ClusterUID:  clusterScope.UID(),
+			Name:        droplet.Name,
+			Role:        role,
+		}),
+	}
+}
+
+// reconcileFirewalls ensures a firewall exists for each droplet role when
+// firewalls are enabled, and corrects any drift from the desired rules.
+// Firewalls are deleted once they are disabled in the DOCluster spec.
+func (r *DOClusterReconciler) reconcileFirewalls(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, lbIDs []string) error {
+	roles := []string{infrav1.APIServerRoleTagValue, infrav1.NodeRoleTagValue}
+	if clusterScope.Firewall() == nil {
+		return r.reconcileDeleteFirewalls(clusterScope, networkingsvc, roles...)
+	}
+
+	vpc, err := networkingsvc.GetClusterVPC()
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		return errors.New("unable to find the VPC of the cluster")
+	}
+
+	for _, role := range roles {
+		if err := r.reconcileFirewall(clusterScope, networkingsvc, role, networkingsvc.FirewallRequest(role, lbIDs, vpc.IPRange)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileFirewall ensures the firewall of the given role exists and matches the request.
+func (r *DOClusterReconciler) reconcileFirewall(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, role string, request *godo.FirewallRequest) error {
+	docluster := clusterScope.DOCluster
+	fwRef := clusterScope.FirewallRef(role)
+
+	fw, err := networkingsvc.GetFirewall(fwRef.ResourceID)
+	if err != nil {
+		return err
+	}
+	if fw == nil {
+		fw, err = networkingsvc.GetFirewallByName(request.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case fw == nil:
+		fw, err = networkingsvc.CreateFirewall(request)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create %s firewall", role)
+		}
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "FirewallCreated", "Created new firewall - %s", fw.Name)
+	case !networking.FirewallMatchesRequest(fw, request):
+		clusterScope.Info("Firewall rules drifted from the desired state, updating", "firewall-id", fw.ID)
+		fw, err = networkingsvc.UpdateFirewall(fw.ID, request)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update %s firewall", role)
+		}
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "FirewallUpdated", "Updated firewall rules - %s", fw.Name)
 	}
 
-	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerReady", "LoadBalancer got an IP Address - %s", loadbalancer.IP)
+	fwRef.ResourceID = fw.ID
+	fwRef.ResourceStatus = infrav1.DOResourceStatus(fw.Status)
+	return nil
+}
+
+// reconcileDeleteFirewalls deletes the firewalls of the given roles managed for the cluster.
+func (r *DOClusterReconciler) reconcileDeleteFirewalls(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, roles ...string) error {
+	docluster := clusterScope.DOCluster
+	for _, role := range roles {
+		fwRef := clusterScope.FirewallRef(role)
+		if fwRef.ResourceID == "" {
+			continue
+		}
+
+		fw, err := networkingsvc.GetFirewall(fwRef.ResourceID)
+		if err != nil {
+			return err
+		}
+		if fw != nil {
+			if err := networkingsvc.DeleteFirewall(fw.ID); err != nil {
+				return errors.Wrapf(err, "error deleting %s firewall", role)
+			}
+			r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "FirewallDeleted", "Deleted a firewall - %s", fw.Name)
+		}
+
+		*fwRef = infrav1.DOResourceReference{}
+	}
+
+	return nil
+}
+
+// reconcileBastion ensures the bastion droplet and its firewall exist when a
+// bastion is configured, and deletes them once it is removed from the spec.
+// The bastion droplet is returned if there is one.
+func (r *DOClusterReconciler) reconcileBastion(ctx context.Context, clusterScope *scope.ClusterScope, networkingsvc *networking.Service) (*godo.Droplet, reconcile.Result, error) {
+	spec := clusterScope.Bastion()
+	if spec == nil {
+		return nil, reconcile.Result{}, r.reconcileDeleteBastion(ctx, clusterScope, networkingsvc)
+	}
+
+	docluster := clusterScope.DOCluster
+	computesvc := computes.NewService(ctx, clusterScope)
+	bastionStatus := clusterScope.BastionStatus()
+
+	if err := r.reconcileFirewall(clusterScope, networkingsvc, infrav1.BastionRoleTagValue, networkingsvc.BastionFirewallRequest(spec)); err != nil {
+		return nil, reconcile.Result{}, err
+	}
+
+	droplet, err := computesvc.GetBastion(bastionStatus.ResourceID)
+	if err != nil {
+		return nil, reconcile.Result{}, err
+	}
+	if droplet == nil {
+		droplet, err = computesvc.CreateBastion(spec)
+		if err != nil {
+			r.Recorder.Event(docluster, corev1.EventTypeWarning, "BastionCreatingError", err.Error())
+			return nil, reconcile.Result{}, err
+		}
+		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "BastionCreated", "Created new bastion instance - %s", droplet.Name)
+	}
 
-	var controlPlaneEndpoint = loadbalancer.IP
-	if docluster.Spec.ControlPlaneDNS != nil {
+	bastionStatus.ResourceID = strconv.Itoa(droplet.ID)
+	bastionStatus.ResourceStatus = infrav1.DOResourceStatus(droplet.Status)
+	bastionStatus.PublicIP, _ = droplet.PublicIPv4()
+	bastionStatus.PrivateIP, _ = droplet.PrivateIPv4()
+
+	if bastionStatus.ResourceStatus != infrav1.DOResourceStatusRunning || bastionStatus.PublicIP == "" {
+		clusterScope.Info("Waiting on bastion instance to be active", "instance-id", bastionStatus.ResourceID)
+		return droplet, reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	return droplet, reconcile.Result{}, nil
+}
+
+// reconcileDeleteBastion deletes the bastion droplet and its firewall.
+func (r *DOClusterReconciler) reconcileDeleteBastion(ctx context.Context, clusterScope *scope.ClusterScope, networkingsvc *networking.Service) error {
+	docluster := clusterScope.DOCluster
+	if docluster.Status.Bastion != nil && docluster.Status.Bastion.ResourceID != "" {
+		computesvc := computes.NewService(ctx, clusterScope)
+		droplet, err := computesvc.GetDroplet(docluster.Status.Bastion.ResourceID)
+		if err != nil {
+			return err
+		}
+		if droplet != nil {
+			if err := computesvc.DeleteDroplet(docluster.Status.Bastion.ResourceID); err != nil {
+				return err
+			}
+			r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "BastionDeleted", "Deleted the bastion instance - %s", droplet.Name)
+		}
+	}
+	clusterScope.ClearBastionStatus()
+
+	return r.reconcileDeleteFirewalls(clusterScope, networkingsvc, infrav1.BastionRoleTagValue)
+}
+
+// controlPlaneDNSAddress is an address record managed for the control plane
+// DNS record.
+type controlPlaneDNSAddress struct {
+	rType string
+	ip    string
+}
+
+// controlPlaneDNSAddresses returns the address records the control plane DNS
+// record consists of. The AAAA record is only managed for dual-stack clusters,
+// and has no address if the endpoint has no IPv6 address.
+func controlPlaneDNSAddresses(clusterScope *scope.ClusterScope, endpointIP, endpointIPv6 string) []controlPlaneDNSAddress {
+	addresses := []controlPlaneDNSAddress{{rType: "A", ip: endpointIP}}
+	if clusterScope.DualStack() {
+		addresses = append(addresses, controlPlaneDNSAddress{rType: "AAAA", ip: endpointIPv6})
+	}
+	return addresses
+}
+
+// reconcileControlPlaneDNS ensures the control plane DNS record points to the
+// endpoint IP, and to its IPv6 address for dual-stack clusters, moving a
+// previously managed record if the spec changed. It returns the host to use as
+// control plane endpoint.
+func (r *DOClusterReconciler) reconcileControlPlaneDNS(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, endpointIP, endpointIPv6 string) (string, reconcile.Result, error) {
+	controlPlaneEndpoint := endpointIP
+	managedRecord := clusterScope.ControlPlaneDNSStatus()
+	if clusterScope.DOCluster.Spec.ControlPlaneDNS != nil {
 		clusterScope.Info("Verifying LB DNS Record")
 		// ensure DNS record is created and use it as control plane endpoint
-		recordSpec := docluster.Spec.ControlPlaneDNS
+		recordSpec := clusterScope.DOCluster.Spec.ControlPlaneDNS
 		controlPlaneEndpoint = fmt.Sprintf("%s.%s", recordSpec.Name, recordSpec.Domain)
-		dRecord, err := networkingsvc.GetDomainRecord(
-			recordSpec.Domain,
-			recordSpec.Name,
-			"A",
-		)
 
-		if err != nil {
-			return reconcile.Result{}, errors.Wrapf(err, "failed verify DNS record for LB Name %s.%s",
-				recordSpec.Name, recordSpec.Domain)
+		// The record is being moved: the new one has to be propagated before
+		// the previously managed record can be removed.
+		recordMoved := managedRecord != nil && !sameControlPlaneDNSRecord(managedRecord, recordSpec)
+		if recordMoved {
+			clusterScope.Info("Control plane DNS record changed", "old", dnsutil.ToFQDN(managedRecord.Name, managedRecord.Domain))
+			clusterScope.SetControlPlaneDNSRecordReady(false)
 		}
 
-		if dRecord == nil || dRecord.Data != loadbalancer.IP {
-			clusterScope.Info("Ensuring LB DNS Record is in place")
-			clusterScope.SetControlPlaneDNSRecordReady(false)
-			if err := networkingsvc.UpsertDomainRecord(
+		addresses := controlPlaneDNSAddresses(clusterScope, endpointIP, endpointIPv6)
+		for _, address := range addresses {
+			if address.ip == "" {
+				if err := networkingsvc.DeleteOwnedDomainRecord(recordSpec.Domain, recordSpec.Name, address.rType, clusterScope.UID(), false); err != nil {
+					if errors.Is(err, networking.ErrDomainRecordNotOwned) {
+						r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeWarning, "DomainRecordNotOwned", "Leaving DNS record in place: %v", err)
+						continue
+					}
+					return "", reconcile.Result{}, errors.Wrapf(err, "failed to delete LB DNS %s record %s.%s",
+						address.rType, recordSpec.Name, recordSpec.Domain)
+				}
+				continue
+			}
+
+			// Only A records were managed before ownership records were
+			// introduced, so only those may be adopted.
+			changed, err := networkingsvc.UpsertOwnedDomainRecord(
 				recordSpec.Domain,
 				recordSpec.Name,
-				"A",
-				loadbalancer.IP,
-			); err != nil {
-				return reconcile.Result{}, errors.Wrap(err, "failed to reconcile LB DNS record")
+				address.rType,
+				address.ip,
+				int(recordSpec.RecordTTL()),
+				clusterScope.UID(),
+				address.rType == "A" && adoptControlPlaneDNSRecord(clusterScope, recordSpec),
+			)
+			if err != nil {
+				if errors.Is(err, networking.ErrDomainRecordNotOwned) {
+					r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeWarning, "DomainRecordNotOwned", "Refusing to update DNS record: %v", err)
+				}
+				return "", reconcile.Result{}, errors.Wrapf(err, "failed to reconcile LB DNS %s record %s.%s",
+					address.rType, recordSpec.Name, recordSpec.Domain)
+			}
+			if changed {
+				clusterScope.Info("LB DNS Record updated", "type", address.rType)
+				clusterScope.SetControlPlaneDNSRecordReady(false)
 			}
 		}
 
@@ -204,68 +1075,462 @@ func (r *DOClusterReconciler) reconcile(ctx context.Context, clusterScope *scope
 		// reconciler hits an NXDOMAIN which is then stored in the negative
 		// cache, so all our retries would fail until the cache TTL is up. This
 		// propagation check works around the DNS cache problem by directly
-		// making DNS queries and not going through system resolvers.
+		// making DNS queries and not going through system resolvers. The doh
+		// resolver is the exception: it can only ask its recursive endpoint.
 		if !clusterScope.DOCluster.Status.ControlPlaneDNSRecordReady {
-			propagated, err := dnsutil.CheckDNSPropagated(dnsutil.ToFQDN(recordSpec.Name, recordSpec.Domain), loadbalancer.IP)
-			if err != nil {
-				return reconcile.Result{}, errors.Wrap(err, "failed to check DNS propagation")
+			propagated := true
+			var results []string
+			for _, address := range addresses {
+				if address.ip == "" {
+					continue
+				}
+				result, err := dnsutil.CheckDNSPropagation(dnsutil.ToFQDN(recordSpec.Name, recordSpec.Domain), address.ip)
+				if err != nil {
+					setControlPlaneDNSPropagatedCondition(clusterScope, metav1.ConditionFalse, infrav1.ControlPlaneDNSPropagationCheckFailedReason, err.Error())
+					return "", reconcile.Result{}, errors.Wrapf(err, "failed to check DNS propagation of the %s record", address.rType)
+				}
+				propagated = propagated && result.IsPropagated()
+				results = append(results, fmt.Sprintf("%s: %s", address.rType, result.String()))
 			}
+			message := strings.Join(results, ", ")
 
 			if !propagated {
-				clusterScope.Info("Waiting for DNS record to be propagated")
-				return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+				setControlPlaneDNSPropagatedCondition(clusterScope, metav1.ConditionFalse, infrav1.ControlPlaneDNSNotPropagatedReason, message)
+				setCondition(clusterScope.DOCluster, infrav1.DNSRecordReadyCondition, metav1.ConditionFalse, infrav1.ControlPlaneDNSNotPropagatedReason,
+					"Waiting for the control plane DNS record to be propagated")
+				clusterScope.Info("Waiting for DNS record to be propagated", "result", message)
+				return "", reconcile.Result{RequeueAfter: 10 * time.Second}, nil
 			}
 
+			setControlPlaneDNSPropagatedCondition(clusterScope, metav1.ConditionTrue, infrav1.ControlPlaneDNSPropagatedReason, message)
 			clusterScope.Info("DNS record is propagated - set DOCluster ControlPlaneDNSRecordReady status to ready")
 			clusterScope.SetControlPlaneDNSRecordReady(true)
 		}
 
+		if recordMoved {
+			if err := r.deleteControlPlaneDNSRecord(clusterScope, networkingsvc, managedRecord); err != nil {
+				return "", reconcile.Result{}, err
+			}
+		}
+		clusterScope.SetControlPlaneDNSStatus(recordSpec.DeepCopy())
+
 		clusterScope.Info("LB DNS Record is already ready")
-		r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "DomainRecordReady", "DNS Record '%s.%s' with IP '%s'", recordSpec.Name, recordSpec.Domain, loadbalancer.IP)
+		r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeNormal, "DomainRecordReady", "DNS Record '%s.%s' with IP '%s'", recordSpec.Name, recordSpec.Domain, endpointIP)
+	} else if managedRecord != nil {
+		if err := r.deleteControlPlaneDNSRecord(clusterScope, networkingsvc, managedRecord); err != nil {
+			return "", reconcile.Result{}, err
+		}
+		clusterScope.SetControlPlaneDNSStatus(nil)
+		clusterScope.SetControlPlaneDNSRecordReady(false)
+		clusterScope.DOCluster.Status.ControlPlaneDNSLastCheckTime = nil
+		conditions.Delete(clusterScope.DOCluster, infrav1.ControlPlaneDNSPropagatedCondition)
 	}
 
-	clusterScope.SetControlPlaneEndpoint(clusterv1beta1.APIEndpoint{
-		Host: controlPlaneEndpoint,
-		Port: apiServerLoadbalancer.Port,
+	return controlPlaneEndpoint, reconcile.Result{}, nil
+}
+
+// setControlPlaneDNSPropagatedCondition records the result and the time of a
+// DNS propagation check. The time is kept out of the condition message, so
+// the condition only changes with the outcome of the check.
+func setControlPlaneDNSPropagatedCondition(clusterScope *scope.ClusterScope, status metav1.ConditionStatus, reason, message string) {
+	now := metav1.Now()
+	clusterScope.DOCluster.Status.ControlPlaneDNSLastCheckTime = &now
+	setCondition(clusterScope.DOCluster, infrav1.ControlPlaneDNSPropagatedCondition, status, reason, message)
+}
+
+// setCondition sets a condition of a DOCluster or DOMachine.
+func setCondition(obj conditions.Setter, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	conditions.Set(obj, metav1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
 	})
+}
 
-	clusterScope.Info("Set DOCluster status to ready")
-	clusterScope.SetReady()
-	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "DOClusterReady", "DOCluster %s - has ready status", clusterScope.Name())
-	return reconcile.Result{}, nil
+// setDNSRecordReadyConditionFromError marks the DNS records as not ready
+// because of err.
+func setDNSRecordReadyConditionFromError(docluster *infrav1.DOCluster, err error) {
+	reason := infrav1.ReconciliationFailedReason
+	if errors.Is(err, networking.ErrDomainRecordNotOwned) {
+		reason = infrav1.DNSRecordNotOwnedReason
+	}
+	setCondition(docluster, infrav1.DNSRecordReadyCondition, metav1.ConditionFalse, reason, err.Error())
+}
+
+// deleteControlPlaneDNSRecord removes a control plane DNS record previously
+// managed for the cluster. Records owned by someone else are left in place.
+func (r *DOClusterReconciler) deleteControlPlaneDNSRecord(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, record *infrav1.DOControlPlaneDNS) error {
+	for _, address := range controlPlaneDNSAddresses(clusterScope, "", "") {
+		adopt := address.rType == "A" && adoptControlPlaneDNSRecord(clusterScope, record)
+		if err := networkingsvc.DeleteOwnedDomainRecord(record.Domain, record.Name, address.rType, clusterScope.UID(), adopt); err != nil {
+			if errors.Is(err, networking.ErrDomainRecordNotOwned) {
+				r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeWarning, "DomainRecordNotOwned", "Leaving DNS record in place: %v", err)
+				continue
+			}
+			return errors.Wrapf(err, "failed to delete DNS %s record %s.%s", address.rType, record.Name, record.Domain)
+		}
+	}
+	r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeNormal, "DomainRecordDeleted", "DNS Record '%s.%s' deleted", record.Name, record.Domain)
+	return nil
+}
+
+// desiredDNSRecord is an additional DNS record the cluster should have.
+type desiredDNSRecord struct {
+	infrav1.DODNSRecordStatus
+	ttl int
+}
+
+func dnsRecordKey(record infrav1.DODNSRecordStatus) string {
+	return fmt.Sprintf("%s/%s/%s", record.Domain, record.Name, record.Type)
 }
 
-func (r *DOClusterReconciler) reconcileDelete(ctx context.Context, clusterScope *scope.ClusterScope) (reconcile.Result, error) { //nolint: unparam
+// reconcileDNSRecords ensures the additional DNS records of the cluster exist
+// and point to their target, and removes the records which are no longer
+// desired.
+func (r *DOClusterReconciler) reconcileDNSRecords(ctx context.Context, clusterScope *scope.ClusterScope, networkingsvc *networking.Service, endpoint *infrav1.DOAPIServerEndpointStatus) error {
+	desired, err := r.desiredDNSRecords(ctx, clusterScope, endpoint)
+	if err != nil {
+		return err
+	}
+	desiredKeys := map[string]bool{}
+	for _, record := range desired {
+		desiredKeys[dnsRecordKey(record.DODNSRecordStatus)] = true
+	}
+
+	var errs []error
+	var notOwned []string
+	managed := map[string]infrav1.DODNSRecordStatus{}
+	// Stale records are removed first as they may conflict with the desired
+	// ones, e.g. when a record changes from A to CNAME.
+	for _, record := range clusterScope.DOCluster.Status.DNSRecords {
+		key := dnsRecordKey(record)
+		managed[key] = record
+		if desiredKeys[key] {
+			continue
+		}
+		if err := r.deleteDNSRecord(clusterScope, networkingsvc, record); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(managed, key)
+	}
+
+	for _, record := range desired {
+		changed, err := networkingsvc.UpsertOwnedDomainRecord(record.Domain, record.Name, record.Type, record.Data, record.ttl, clusterScope.UID(), false)
+		if err != nil {
+			if errors.Is(err, networking.ErrDomainRecordNotOwned) {
+				r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeWarning, "DomainRecordNotOwned", "Refusing to update DNS record: %v", err)
+				notOwned = append(notOwned, fmt.Sprintf("%s record %s.%s", record.Type, record.Name, record.Domain))
+				continue
+			}
+			errs = append(errs, errors.Wrapf(err, "failed to reconcile %s record %s.%s", record.Type, record.Name, record.Domain))
+			continue
+		}
+		if changed {
+			r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeNormal, "DomainRecordReady", "DNS Record '%s.%s' with %s '%s'", record.Name, record.Domain, record.Type, record.Data)
+		}
+		managed[dnsRecordKey(record.DODNSRecordStatus)] = record.DODNSRecordStatus
+	}
+
+	records := make([]infrav1.DODNSRecordStatus, 0, len(managed))
+	for _, record := range managed {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return dnsRecordKey(records[i]) < dnsRecordKey(records[j])
+	})
+	if len(records) == 0 {
+		records = nil
+	}
+	clusterScope.SetDNSRecords(records)
+
+	// Errors are reported by the caller.
+	switch {
+	case len(errs) > 0:
+	case len(notOwned) > 0:
+		setCondition(clusterScope.DOCluster, infrav1.DNSRecordReadyCondition, metav1.ConditionFalse, infrav1.DNSRecordNotOwnedReason,
+			fmt.Sprintf("Refusing to update records owned by someone else: %s", strings.Join(notOwned, ", ")))
+	case clusterScope.DOCluster.Spec.ControlPlaneDNS != nil || len(records) > 0:
+		setCondition(clusterScope.DOCluster, infrav1.DNSRecordReadyCondition, metav1.ConditionTrue, infrav1.ReadyReason, "")
+	default:
+		conditions.Delete(clusterScope.DOCluster, infrav1.DNSRecordReadyCondition)
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// desiredDNSRecords resolves the targets of the additional DNS records of the
+// cluster. AAAA records are skipped for targets without an IPv6 address.
+func (r *DOClusterReconciler) desiredDNSRecords(ctx context.Context, clusterScope *scope.ClusterScope, endpoint *infrav1.DOAPIServerEndpointStatus) ([]desiredDNSRecord, error) {
+	var records []desiredDNSRecord
+	var machines []infrav1.DOMachine
+	for i := range clusterScope.DOCluster.Spec.AdditionalDNSRecords {
+		spec := &clusterScope.DOCluster.Spec.AdditionalDNSRecords[i]
+		domain := spec.Domain
+		if domain == "" && clusterScope.DOCluster.Spec.ControlPlaneDNS != nil {
+			domain = clusterScope.DOCluster.Spec.ControlPlaneDNS.Domain
+		}
+		record := desiredDNSRecord{
+			DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: domain, Name: spec.Name, Type: spec.RecordType()},
+			ttl:               int(spec.RecordTTL()),
+		}
+
+		switch spec.Target.Type {
+		case infrav1.DODNSRecordTargetAPIServer:
+			record.Data = endpoint.Host
+			if record.Type == "AAAA" {
+				record.Data = endpoint.HostIPv6
+			}
+			if record.Data == "" {
+				continue
+			}
+			records = append(records, record)
+		case infrav1.DODNSRecordTargetValue:
+			record.Data = spec.Target.Value
+			if record.Type == "CNAME" && !strings.HasSuffix(record.Data, ".") {
+				record.Data += "."
+			}
+			records = append(records, record)
+		case infrav1.DODNSRecordTargetControlPlaneMachines:
+			if machines == nil {
+				var err error
+				if machines, err = r.listControlPlaneDOMachines(ctx, clusterScope); err != nil {
+					return nil, err
+				}
+			}
+			addressType := spec.Target.AddressType
+			if addressType == "" {
+				addressType = corev1.NodeExternalIP
+			}
+			// Machines without an address are skipped, so that the records
+			// are numbered without gaps.
+			n := 0
+			for _, machine := range machines {
+				address := machineAddress(&machine, addressType, record.Type == "AAAA")
+				if address == "" {
+					continue
+				}
+				machineRecord := record
+				machineRecord.Name = fmt.Sprintf("%s-%d", spec.Name, n)
+				machineRecord.Data = address
+				records = append(records, machineRecord)
+				n++
+			}
+		}
+	}
+	return records, nil
+}
+
+// listControlPlaneDOMachines returns the control plane DOMachines of the
+// cluster which are not being deleted, sorted by name.
+func (r *DOClusterReconciler) listControlPlaneDOMachines(ctx context.Context, clusterScope *scope.ClusterScope) ([]infrav1.DOMachine, error) {
+	list := &infrav1.DOMachineList{}
+	if err := r.List(ctx, list,
+		client.InNamespace(clusterScope.Namespace()),
+		client.MatchingLabels{clusterv1beta2.ClusterNameLabel: clusterScope.Name()},
+		client.HasLabels{clusterv1beta2.MachineControlPlaneLabel},
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to list control plane DOMachines")
+	}
+
+	machines := make([]infrav1.DOMachine, 0, len(list.Items))
+	for _, machine := range list.Items {
+		if machine.DeletionTimestamp.IsZero() {
+			machines = append(machines, machine)
+		}
+	}
+	sort.Slice(machines, func(i, j int) bool {
+		return machines[i].Name < machines[j].Name
+	})
+	return machines, nil
+}
+
+// machineAddress returns the first address of the given type and IP family of
+// the machine.
+func machineAddress(machine *infrav1.DOMachine, addressType corev1.NodeAddressType, ipv6 bool) string {
+	for _, address := range machine.Status.Addresses {
+		if address.Type != addressType {
+			continue
+		}
+		if ip := net.ParseIP(address.Address); ip != nil && (ip.To4() == nil) == ipv6 {
+			return address.Address
+		}
+	}
+	return ""
+}
+
+// deleteDNSRecord removes an additional DNS record managed for the cluster.
+// Records owned by someone else are left in place.
+func (r *DOClusterReconciler) deleteDNSRecord(clusterScope *scope.ClusterScope, networkingsvc *networking.Service, record infrav1.DODNSRecordStatus) error {
+	if err := networkingsvc.DeleteOwnedDomainRecord(record.Domain, record.Name, record.Type, clusterScope.UID(), false); err != nil {
+		if errors.Is(err, networking.ErrDomainRecordNotOwned) {
+			r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeWarning, "DomainRecordNotOwned", "Leaving DNS record in place: %v", err)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete %s record %s.%s", record.Type, record.Name, record.Domain)
+	}
+	r.Recorder.Eventf(clusterScope.DOCluster, corev1.EventTypeNormal, "DomainRecordDeleted", "DNS Record '%s.%s' deleted", record.Name, record.Domain)
+	return nil
+}
+
+// sameControlPlaneDNSRecord returns whether both control plane DNS records
+// designate the same record, regardless of their settings.
+func sameControlPlaneDNSRecord(a, b *infrav1.DOControlPlaneDNS) bool {
+	return a.Domain == b.Domain && a.Name == b.Name
+}
+
+// adoptControlPlaneDNSRecord returns whether the given record was created for
+// the cluster before ownership records were introduced, in which case it may
+// be taken over even though it has no ownership record.
+func adoptControlPlaneDNSRecord(clusterScope *scope.ClusterScope, record *infrav1.DOControlPlaneDNS) bool {
+	if managedRecord := clusterScope.ControlPlaneDNSStatus(); managedRecord != nil {
+		return sameControlPlaneDNSRecord(managedRecord, record)
+	}
+	recordSpec := clusterScope.DOCluster.Spec.ControlPlaneDNS
+	return clusterScope.DOCluster.Status.ControlPlaneDNSRecordReady && recordSpec != nil && sameControlPlaneDNSRecord(recordSpec, record)
+}
+
+func (r *DOClusterReconciler) reconcileDelete(ctx context.Context, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
 	clusterScope.Info("Reconciling delete DOCluster")
 	docluster := clusterScope.DOCluster
+	for _, conditionType := range []string{infrav1.VPCReadyCondition, infrav1.LoadBalancerReadyCondition, infrav1.DNSRecordReadyCondition} {
+		if conditions.Has(docluster, conditionType) {
+			setCondition(docluster, conditionType, metav1.ConditionFalse, infrav1.DeletingReason, "")
+		}
+	}
 	networkingsvc := networking.NewService(ctx, clusterScope)
 	apiServerLoadbalancerRef := clusterScope.APIServerLoadbalancersRef()
 
-	if docluster.Spec.ControlPlaneDNS != nil {
-		recordSpec := docluster.Spec.ControlPlaneDNS
-		if err := networkingsvc.DeleteDomainRecord(recordSpec.Domain, recordSpec.Name, "A"); err != nil {
+	// Delete both the record recorded in status and the one named by the spec,
+	// as the latter may already exist while a record move is in progress.
+	for _, record := range docluster.Status.DNSRecords {
+		if err := r.deleteDNSRecord(clusterScope, networkingsvc, record); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	clusterScope.SetDNSRecords(nil)
+
+	managedRecord := clusterScope.ControlPlaneDNSStatus()
+	if managedRecord != nil {
+		if err := r.deleteControlPlaneDNSRecord(clusterScope, networkingsvc, managedRecord); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if recordSpec := docluster.Spec.ControlPlaneDNS; recordSpec != nil && (managedRecord == nil || !sameControlPlaneDNSRecord(managedRecord, recordSpec)) {
+		if err := r.deleteControlPlaneDNSRecord(clusterScope, networkingsvc, recordSpec); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 
-	loadbalancer, err := networkingsvc.GetLoadBalancer(apiServerLoadbalancerRef.ResourceID)
+	if clusterScope.APIServerEndpointType() == infrav1.DOAPIServerEndpointTypeReservedIP {
+		if result, err := r.reconcileDeleteReservedIP(clusterScope, networkingsvc); err != nil || !result.IsZero() {
+			return result, err
+		}
+	} else {
+		if err := r.deleteLoadBalancer(clusterScope, networkingsvc, apiServerLoadbalancerRef); err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "error deleting load balancer for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+		}
+
+		if ref := clusterScope.APIServerPublicLoadbalancerRef(); ref.ResourceID != "" {
+			if err := r.deleteLoadBalancer(clusterScope, networkingsvc, ref); err != nil {
+				return reconcile.Result{}, errors.Wrapf(err, "error deleting public load balancer for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+			}
+		}
+	}
+
+	if err := r.reconcileDeleteBastion(ctx, clusterScope, networkingsvc); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to delete bastion for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	if err := r.reconcileDeleteFirewalls(clusterScope, networkingsvc, infrav1.APIServerRoleTagValue, infrav1.NodeRoleTagValue); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to delete firewalls for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	if result, err := r.reconcileDeleteVPC(clusterScope, networkingsvc); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// Cluster is deleted so remove the finalizer.
+	controllerutil.RemoveFinalizer(docluster, infrav1.ClusterFinalizer)
+	return reconcile.Result{}, nil
+}
+
+// reconcileDeleteReservedIP releases the reserved IP used as control plane
+// endpoint, unassigning it first if it is still assigned to a droplet.
+func (r *DOClusterReconciler) reconcileDeleteReservedIP(clusterScope *scope.ClusterScope, networkingsvc *networking.Service) (reconcile.Result, error) {
+	docluster := clusterScope.DOCluster
+	reservedIPRef := clusterScope.APIServerReservedIP()
+
+	reservedIP, err := networkingsvc.GetReservedIP(reservedIPRef.IP)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+	if reservedIP == nil {
+		clusterScope.V(2).Info("Unable to locate reserved IP")
+		*reservedIPRef = infrav1.DOReservedIPResource{}
+		return reconcile.Result{}, nil
+	}
 
-	if loadbalancer == nil {
-		clusterScope.V(2).Info("Unable to locate load balancer")
-		r.Recorder.Eventf(docluster, corev1.EventTypeWarning, "NoLoadBalancerFound", "Unable to find matching load balancer")
-		controllerutil.RemoveFinalizer(docluster, infrav1.ClusterFinalizer)
+	if reservedIP.Droplet != nil {
+		if err := networkingsvc.UnassignReservedIP(reservedIP.IP); err != nil {
+			return reconcile.Result{}, err
+		}
+		clusterScope.Info("Waiting for reserved IP to be unassigned", "ip", reservedIP.IP)
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if err := networkingsvc.DeleteReservedIP(reservedIP.IP); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting reserved IP for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "ReservedIPDeleted", "Deleted a reserved IP - %s", reservedIP.IP)
+	*reservedIPRef = infrav1.DOReservedIPResource{}
+	return reconcile.Result{}, nil
+}
+
+// reconcileDeleteVPC deletes the VPC managed for the cluster once all the
+// droplets and load balancers placed in it are gone.
+func (r *DOClusterReconciler) reconcileDeleteVPC(clusterScope *scope.ClusterScope, networkingsvc *networking.Service) (reconcile.Result, error) {
+	if !clusterScope.VPC().IsManaged() {
 		return reconcile.Result{}, nil
 	}
 
-	if err := networkingsvc.DeleteLoadBalancer(loadbalancer.ID); err != nil {
-		return reconcile.Result{}, errors.Wrapf(err, "error deleting load balancer for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	docluster := clusterScope.DOCluster
+	vpcRef := clusterScope.VPCRef()
+
+	vpc, err := networkingsvc.GetVPC(vpcRef.ResourceID)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if vpc == nil {
+		clusterScope.V(2).Info("Unable to locate VPC")
+		vpcRef.ResourceID = ""
+		return reconcile.Result{}, nil
+	}
+	if vpcRef.Ownership == "" {
+		vpcRef.Ownership = vpcOwnership(docluster, clusterScope.VPC(), vpc)
+	}
+	if vpcRef.Ownership == infrav1.DOResourceUnmanaged {
+		clusterScope.Info("Leaving adopted VPC in place", "vpc-id", vpc.ID)
+		vpcRef.ResourceID = ""
+		return reconcile.Result{}, nil
 	}
 
-	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "LoadBalancerDeleted", "Deleted an LoadBalancer - %s", loadbalancer.Name)
-	// Cluster is deleted so remove the finalizer.
-	controllerutil.RemoveFinalizer(docluster, infrav1.ClusterFinalizer)
+	members, err := networkingsvc.ListVPCMembers(vpc.ID)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to list members of VPC %s", vpc.ID)
+	}
+	if len(members) > 0 {
+		clusterScope.Info("Waiting for VPC members to be deleted", "vpc-id", vpc.ID, "members", len(members))
+		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	if err := networkingsvc.DeleteVPC(vpc.ID); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting VPC for DOCluster %s/%s", docluster.Namespace, docluster.Name)
+	}
+
+	r.Recorder.Eventf(docluster, corev1.EventTypeNormal, "VPCDeleted", "Deleted a VPC - %s", vpc.Name)
+	vpcRef.ResourceID = ""
 	return reconcile.Result{}, nil
 }
@@ -0,0 +1,1037 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computesenhanced/mock_computesenhanced"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/networking"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/networking/mock_networking"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/projects"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/projects/mock_projects"
+)
+
+func newControlPlaneDroplet(id int, ip, status string) godo.Droplet {
+	return godo.Droplet{
+		ID:     id,
+		Name:   "cp",
+		Status: status,
+		Networks: &godo.Networks{
+			V4: []godo.NetworkV4{{IPAddress: ip, Type: "public"}},
+		},
+	}
+}
+
+func TestDOClusterReconciler_reconcileReservedIP(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newDOCluster := func() *infrav1.DOCluster {
+		return &infrav1.DOCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", Namespace: "default"},
+			Spec: infrav1.DOClusterSpec{
+				Region: "nyc1",
+				Network: infrav1.DONetwork{
+					APIServerEndpoint: infrav1.DOAPIServerEndpoint{Type: infrav1.DOAPIServerEndpointTypeReservedIP},
+				},
+			},
+		}
+	}
+	newClusterScope := func(g *WithT, c client.Client, docluster *infrav1.DOCluster, mrips *mock_networking.MockReservedIPsService) *scope.ClusterScope {
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client: c,
+			Cluster: &clusterv1beta2.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+			},
+			DOCluster: docluster,
+			DOClients: scope.DOClients{ReservedIPs: mrips},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		return clusterScope
+	}
+
+	t.Run("created reserved IP is reused after a failed reconcile", func(t *testing.T) {
+		g := NewWithT(t)
+		mctrl := gomock.NewController(t)
+
+		docluster := newDOCluster()
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(docluster).WithStatusSubresource(docluster).Build()
+		r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+
+		mrips := mock_networking.NewMockReservedIPsService(mctrl)
+		mrips.EXPECT().Create(gomock.Any(), &godo.ReservedIPCreateRequest{Region: "nyc1"}).Return(&godo.ReservedIP{IP: "203.0.113.1"}, nil, nil)
+		clusterScope := newClusterScope(g, c, docluster, mrips)
+		_, err := r.reconcileReservedIP(clusterScope, networking.NewService(context.TODO(), clusterScope))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		// The reconcile fails later on, without persisting the scope: the
+		// next one starts again from the stored DOCluster.
+		stored := &infrav1.DOCluster{}
+		g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(docluster), stored)).To(Succeed())
+		g.Expect(stored.Status.Network.APIServerReservedIP.IP).To(Equal("203.0.113.1"))
+
+		mrips = mock_networking.NewMockReservedIPsService(mctrl)
+		mrips.EXPECT().Get(gomock.Any(), "203.0.113.1").Return(&godo.ReservedIP{IP: "203.0.113.1"}, nil, nil)
+		clusterScope = newClusterScope(g, c, stored, mrips)
+		reservedIP, err := r.reconcileReservedIP(clusterScope, networking.NewService(context.TODO(), clusterScope))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(reservedIP.IP).To(Equal("203.0.113.1"))
+	})
+
+	t.Run("created reserved IP is released if it can't be recorded", func(t *testing.T) {
+		g := NewWithT(t)
+		mctrl := gomock.NewController(t)
+
+		docluster := newDOCluster()
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(docluster).WithStatusSubresource(docluster).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourcePatch: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+					return errors.New("connection refused")
+				},
+			}).Build()
+		r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+
+		mrips := mock_networking.NewMockReservedIPsService(mctrl)
+		mrips.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.ReservedIP{IP: "203.0.113.1"}, nil, nil)
+		mrips.EXPECT().Delete(gomock.Any(), "203.0.113.1").Return(nil, nil)
+		clusterScope := newClusterScope(g, c, docluster, mrips)
+		_, err := r.reconcileReservedIP(clusterScope, networking.NewService(context.TODO(), clusterScope))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(docluster.Status.Network.APIServerReservedIP.IP).To(BeEmpty())
+	})
+}
+
+func TestDOClusterReconciler_reconcileReservedIPFailover(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	droplets := []godo.Droplet{
+		newControlPlaneDroplet(2, "192.0.2.2", "active"),
+		newControlPlaneDroplet(1, "192.0.2.1", "active"),
+		newControlPlaneDroplet(3, "192.0.2.3", "new"),
+	}
+
+	tests := []struct {
+		name       string
+		holder     string
+		healthy    map[string]bool
+		wantAssign int
+	}{
+		{
+			name:    "healthy holder is kept",
+			holder:  "2",
+			healthy: map[string]bool{"192.0.2.1": true, "192.0.2.2": true},
+		},
+		{
+			name:       "unhealthy holder is replaced by a healthy droplet",
+			holder:     "1",
+			healthy:    map[string]bool{"192.0.2.2": true},
+			wantAssign: 2,
+		},
+		{
+			name:       "deleted holder is replaced by a healthy droplet",
+			holder:     "42",
+			healthy:    map[string]bool{"192.0.2.2": true},
+			wantAssign: 2,
+		},
+		{
+			name:    "unhealthy holder is kept if no other droplet is healthy",
+			holder:  "1",
+			healthy: map[string]bool{"192.0.2.3": true},
+		},
+		{
+			name:       "unassigned reserved IP goes to the first active droplet while none is healthy",
+			healthy:    map[string]bool{},
+			wantAssign: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mdroplets := mock_computesenhanced.NewMockDropletsService(mctrl)
+			mdroplets.EXPECT().ListByTag(gomock.Any(), "sigs-k8s-io:capdo:capdo-test:1234:apiserver", gomock.Any()).Return(droplets, nil, nil)
+			mactions := mock_networking.NewMockReservedIPActionsService(mctrl)
+			if tt.wantAssign != 0 {
+				mactions.EXPECT().Assign(gomock.Any(), "203.0.113.1", tt.wantAssign).Return(&godo.Action{}, nil, nil)
+			}
+
+			docluster := &infrav1.DOCluster{
+				Spec: infrav1.DOClusterSpec{
+					Network: infrav1.DONetwork{
+						APIServerEndpoint: infrav1.DOAPIServerEndpoint{Type: infrav1.DOAPIServerEndpointTypeReservedIP},
+					},
+				},
+				Status: infrav1.DOClusterStatus{
+					Network: infrav1.DONetworkResource{
+						APIServerReservedIP: infrav1.DOReservedIPResource{IP: "203.0.113.1", DropletID: tt.holder},
+					},
+				},
+			}
+			docluster.Spec.Network.APIServerLoadbalancers.ApplyDefault()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+				},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{
+					Droplets:          mdroplets,
+					ReservedIPActions: mactions,
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOClusterReconciler{
+				Recorder: record.NewFakeRecorder(10),
+				APIServerHealthCheck: func(_ context.Context, host string, port int32) bool {
+					g.Expect(port).To(Equal(infrav1.DefaultLBPort))
+					return tt.healthy[host]
+				},
+			}
+			networkingsvc := networking.NewService(context.TODO(), clusterScope)
+			g.Expect(r.reconcileReservedIPFailover(context.TODO(), clusterScope, networkingsvc)).To(Succeed())
+
+			wantHolder := tt.holder
+			if tt.wantAssign != 0 {
+				wantHolder = strconv.Itoa(tt.wantAssign)
+			}
+			g.Expect(docluster.Status.Network.APIServerReservedIP.DropletID).To(Equal(wantHolder))
+		})
+	}
+}
+
+func TestPrimaryAPIServerEndpoint(t *testing.T) {
+	private := infrav1.DOAPIServerEndpointStatus{Visibility: infrav1.DOEndpointVisibilityPrivate, Host: "10.10.0.5", Port: 6443}
+	public := infrav1.DOAPIServerEndpointStatus{Visibility: infrav1.DOEndpointVisibilityPublic, Host: "203.0.113.1", Port: 443}
+
+	tests := []struct {
+		name       string
+		endpoints  []infrav1.DOAPIServerEndpointStatus
+		visibility infrav1.DOEndpointVisibility
+		wantHost   string
+	}{
+		{
+			name:       "private endpoint is primary",
+			endpoints:  []infrav1.DOAPIServerEndpointStatus{private, public},
+			visibility: infrav1.DOEndpointVisibilityPrivate,
+			wantHost:   private.Host,
+		},
+		{
+			name:       "public endpoint is primary",
+			endpoints:  []infrav1.DOAPIServerEndpointStatus{private, public},
+			visibility: infrav1.DOEndpointVisibilityPublic,
+			wantHost:   public.Host,
+		},
+		{
+			name:       "falls back to the only endpoint",
+			endpoints:  []infrav1.DOAPIServerEndpointStatus{public},
+			visibility: infrav1.DOEndpointVisibilityPrivate,
+			wantHost:   public.Host,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := primaryAPIServerEndpoint(tt.endpoints, tt.visibility)
+			g.Expect(got.Host).To(Equal(tt.wantHost))
+
+			primaries := 0
+			for _, endpoint := range tt.endpoints {
+				if endpoint.Primary {
+					primaries++
+					g.Expect(endpoint.Host).To(Equal(tt.wantHost))
+				}
+			}
+			g.Expect(primaries).To(Equal(1))
+		})
+	}
+}
+
+func TestDOClusterReconciler_reconcileExternallyManaged(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		endpoint  clusterv1beta2.APIEndpoint
+		wantReady bool
+	}{
+		{name: "IP address", endpoint: clusterv1beta2.APIEndpoint{Host: "203.0.113.1", Port: 6443}, wantReady: true},
+		{name: "DNS name", endpoint: clusterv1beta2.APIEndpoint{Host: "api.example.com", Port: 443}, wantReady: true},
+		{name: "not set yet", endpoint: clusterv1beta2.APIEndpoint{}},
+		{name: "invalid host", endpoint: clusterv1beta2.APIEndpoint{Host: "api_example.com", Port: 6443}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			docluster := &infrav1.DOCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{clusterv1beta2.ManagedByAnnotation: ""},
+				},
+				Spec: infrav1.DOClusterSpec{ControlPlaneEndpoint: tt.endpoint},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster:   newCluster("capdo-test"),
+				DOCluster: docluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			_, err = r.reconcileExternallyManaged(clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(docluster.Status.Initialization != nil && ptr.Deref(docluster.Status.Initialization.Provisioned, false)).To(Equal(tt.wantReady))
+			g.Expect(docluster.Finalizers).To(BeEmpty())
+		})
+	}
+}
+
+func TestDOClusterReconciler_reconcileControlPlaneDNS(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldRecord := &infrav1.DOControlPlaneDNS{Domain: "example.com", Name: "old"}
+	newRecord := &infrav1.DOControlPlaneDNS{Domain: "example.com", Name: "new"}
+
+	tests := []struct {
+		name         string
+		spec         *infrav1.DOControlPlaneDNS
+		ipFamily     infrav1.DOIPFamily
+		endpointIPv6 string
+		expect       func(m *mock_networking.MockDomainsService)
+		wantErr      bool
+		wantStatus   *infrav1.DOControlPlaneDNS
+	}{
+		{
+			name: "old record is kept until the new one is propagated",
+			spec: newRecord,
+			expect: func(m *mock_networking.MockDomainsService) {
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "TXT", "capdo-a-new.example.com", gomock.Any()).Return(nil, nil, nil).Times(2)
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "A", "new.example.com", gomock.Any()).Return(nil, nil, nil).Times(2)
+				m.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+					Type: "TXT", Name: "capdo-a-new", Data: "heritage=cluster-api-provider-digitalocean,capdo/owner=1234", TTL: 30,
+				}).Return(&godo.DomainRecord{}, nil, nil)
+				m.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+					Type: "A", Name: "new", Data: "192.0.2.1", TTL: 30,
+				}).Return(&godo.DomainRecord{}, nil, nil)
+			},
+			// The default test resolver has no authority section.
+			wantErr:    true,
+			wantStatus: oldRecord,
+		},
+		{
+			name: "record owned by another cluster is not updated",
+			spec: newRecord,
+			expect: func(m *mock_networking.MockDomainsService) {
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "TXT", "capdo-a-new.example.com", gomock.Any()).Return([]godo.DomainRecord{
+					{ID: 8, Data: "heritage=cluster-api-provider-digitalocean,capdo/owner=5678"},
+				}, nil, nil)
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "A", "new.example.com", gomock.Any()).Return([]godo.DomainRecord{{ID: 7, Data: "198.51.100.1"}}, nil, nil)
+			},
+			wantErr:    true,
+			wantStatus: oldRecord,
+		},
+		{
+			name:         "AAAA record is added for dual-stack clusters",
+			spec:         oldRecord,
+			ipFamily:     infrav1.DOIPFamilyDualStack,
+			endpointIPv6: "2001:db8::1",
+			expect: func(m *mock_networking.MockDomainsService) {
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "TXT", "capdo-a-old.example.com", gomock.Any()).Return([]godo.DomainRecord{
+					{ID: 8, Data: "heritage=cluster-api-provider-digitalocean,capdo/owner=1234"},
+				}, nil, nil)
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "A", "old.example.com", gomock.Any()).Return([]godo.DomainRecord{{ID: 7, Data: "192.0.2.1", TTL: 30}}, nil, nil)
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "TXT", "capdo-aaaa-old.example.com", gomock.Any()).Return(nil, nil, nil).Times(2)
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "AAAA", "old.example.com", gomock.Any()).Return(nil, nil, nil).Times(2)
+				m.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+					Type: "TXT", Name: "capdo-aaaa-old", Data: "heritage=cluster-api-provider-digitalocean,capdo/owner=1234", TTL: 30,
+				}).Return(&godo.DomainRecord{}, nil, nil)
+				m.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+					Type: "AAAA", Name: "old", Data: "2001:db8::1", TTL: 30,
+				}).Return(&godo.DomainRecord{}, nil, nil)
+			},
+			// The default test resolver has no authority section.
+			wantErr:    true,
+			wantStatus: oldRecord,
+		},
+		{
+			name: "record is deleted when removed from the spec",
+			expect: func(m *mock_networking.MockDomainsService) {
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "TXT", "capdo-a-old.example.com", gomock.Any()).Return([]godo.DomainRecord{
+					{ID: 8, Data: `"heritage=cluster-api-provider-digitalocean,capdo/owner=1234"`},
+				}, nil, nil).Times(2)
+				m.EXPECT().RecordsByTypeAndName(gomock.Any(), "example.com", "A", "old.example.com", gomock.Any()).Return([]godo.DomainRecord{{ID: 7, Data: "192.0.2.1"}}, nil, nil)
+				m.EXPECT().DeleteRecord(gomock.Any(), "example.com", 7).Return(nil, nil)
+				m.EXPECT().DeleteRecord(gomock.Any(), "example.com", 8).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mdomains := mock_networking.NewMockDomainsService(mctrl)
+			tt.expect(mdomains)
+
+			docluster := &infrav1.DOCluster{
+				Spec: infrav1.DOClusterSpec{
+					ControlPlaneDNS: tt.spec,
+					Network:         infrav1.DONetwork{IPFamily: tt.ipFamily},
+				},
+				Status: infrav1.DOClusterStatus{
+					ControlPlaneDNSRecordReady: true,
+					ControlPlaneDNS:            oldRecord.DeepCopy(),
+				},
+			}
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+				},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{Domains: mdomains},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			networkingsvc := networking.NewService(context.TODO(), clusterScope)
+
+			_, _, err = r.reconcileControlPlaneDNS(clusterScope, networkingsvc, "192.0.2.1", tt.endpointIPv6)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(docluster.Status.ControlPlaneDNS).To(Equal(tt.wantStatus))
+			g.Expect(docluster.Status.ControlPlaneDNSRecordReady).To(BeFalse())
+		})
+	}
+}
+
+func newControlPlaneDOMachine(name, externalIP string) *infrav1.DOMachine {
+	m := &infrav1.DOMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				clusterv1beta2.ClusterNameLabel:         "capdo-test",
+				clusterv1beta2.MachineControlPlaneLabel: "",
+			},
+		},
+	}
+	if externalIP != "" {
+		m.Status.Addresses = []corev1.NodeAddress{{Type: corev1.NodeExternalIP, Address: externalIP}}
+	}
+	return m
+}
+
+func TestDOClusterReconciler_desiredDNSRecords(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	g := NewWithT(t)
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	worker := newControlPlaneDOMachine("worker", "198.51.100.9")
+	delete(worker.Labels, clusterv1beta2.MachineControlPlaneLabel)
+	dualStack := newControlPlaneDOMachine("cp-b", "198.51.100.2")
+	dualStack.Status.Addresses = append(dualStack.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "2001:db8::1"})
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newControlPlaneDOMachine("cp-a", ""),
+		newControlPlaneDOMachine("cp-c", "198.51.100.3"),
+		dualStack,
+		worker,
+	).Build()
+
+	docluster := &infrav1.DOCluster{
+		Spec: infrav1.DOClusterSpec{
+			ControlPlaneDNS: &infrav1.DOControlPlaneDNS{Domain: "example.com", Name: "api"},
+			AdditionalDNSRecords: []infrav1.DODNSRecord{
+				{Name: "*.apps", Target: infrav1.DODNSRecordTarget{Type: infrav1.DODNSRecordTargetAPIServer}},
+				{Name: "*.apps", Type: "AAAA", Target: infrav1.DODNSRecordTarget{Type: infrav1.DODNSRecordTargetAPIServer}},
+				{Name: "cp", TTL: 60, Target: infrav1.DODNSRecordTarget{Type: infrav1.DODNSRecordTargetControlPlaneMachines}},
+				{Name: "cp6", Type: "AAAA", Target: infrav1.DODNSRecordTarget{Type: infrav1.DODNSRecordTargetControlPlaneMachines}},
+				{Name: "docs", Domain: "example.org", Type: "CNAME", Target: infrav1.DODNSRecordTarget{Type: infrav1.DODNSRecordTargetValue, Value: "docs.example.com"}},
+			},
+		},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: c,
+		Cluster: &clusterv1beta2.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", Namespace: "default", UID: types.UID("1234")},
+		},
+		DOCluster: docluster,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	r := &DOClusterReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+	endpoint := &infrav1.DOAPIServerEndpointStatus{Host: "192.0.2.1", HostIPv6: "2001:db8::2"}
+	records, err := r.desiredDNSRecords(context.TODO(), clusterScope, endpoint)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(Equal([]desiredDNSRecord{
+		{DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: "example.com", Name: "*.apps", Type: "A", Data: "192.0.2.1"}, ttl: 30},
+		{DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: "example.com", Name: "*.apps", Type: "AAAA", Data: "2001:db8::2"}, ttl: 30},
+		{DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: "example.com", Name: "cp-0", Type: "A", Data: "198.51.100.2"}, ttl: 60},
+		{DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: "example.com", Name: "cp-1", Type: "A", Data: "198.51.100.3"}, ttl: 60},
+		{DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: "example.com", Name: "cp6-0", Type: "AAAA", Data: "2001:db8::1"}, ttl: 30},
+		{DODNSRecordStatus: infrav1.DODNSRecordStatus{Domain: "example.org", Name: "docs", Type: "CNAME", Data: "docs.example.com."}, ttl: 30},
+	}))
+}
+
+func TestDOClusterReconciler_reconcileLoadBalancer(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		lbName        string
+		ownership     infrav1.DOResourceOwnership
+		wantOwnership infrav1.DOResourceOwnership
+	}{
+		{
+			name:          "recorded load balancer keeps its ownership",
+			lbName:        "user-lb",
+			ownership:     infrav1.DOResourceOwned,
+			wantOwnership: infrav1.DOResourceOwned,
+		},
+		{
+			name:          "legacy load balancer named after the cluster is owned",
+			wantOwnership: infrav1.DOResourceOwned,
+		},
+		{
+			name:          "legacy load balancer supplied by the user is unmanaged",
+			lbName:        "user-lb",
+			wantOwnership: infrav1.DOResourceUnmanaged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mlbs := mock_networking.NewMockLoadBalancersService(mctrl)
+			docluster := &infrav1.DOCluster{
+				Spec: infrav1.DOClusterSpec{Region: "nyc1", Network: infrav1.DONetwork{
+					APIServerLoadbalancers: infrav1.DOLoadBalancer{ResourceID: "lb-1"},
+				}},
+				Status: infrav1.DOClusterStatus{Network: infrav1.DONetworkResource{
+					APIServerLoadbalancersRef: infrav1.DOResourceReference{ResourceID: "lb-1", Ownership: tt.ownership},
+				}},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+				},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{LoadBalancers: mlbs},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			networkingsvc := networking.NewService(context.TODO(), clusterScope)
+
+			spec := &docluster.Spec.Network.APIServerLoadbalancers
+			spec.ApplyDefault()
+			request := networkingsvc.LoadBalancerRequest(spec)
+			lbName := tt.lbName
+			if lbName == "" {
+				lbName = request.Name
+			}
+			// Load balancers still being provisioned are neither updated nor
+			// tagged, so only the recorded ownership is exercised.
+			mlbs.EXPECT().Get(gomock.Any(), "lb-1").Return(&godo.LoadBalancer{
+				ID:     "lb-1",
+				Name:   lbName,
+				Status: string(infrav1.DOResourceStatusNew),
+				Tags:   []string{networkingsvc.LoadBalancerTag()},
+			}, nil, nil)
+
+			ref := &docluster.Status.Network.APIServerLoadbalancersRef
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			_, err = r.reconcileLoadBalancer(clusterScope, networkingsvc, spec, ref, request)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ref.Ownership).To(Equal(tt.wantOwnership))
+		})
+	}
+}
+
+func TestDOClusterReconciler_deleteLoadBalancer(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		lbName     string
+		ownership  infrav1.DOResourceOwnership
+		wantDelete bool
+	}{
+		{
+			name:       "owned load balancer is deleted",
+			lbName:     "user-lb",
+			ownership:  infrav1.DOResourceOwned,
+			wantDelete: true,
+		},
+		{
+			name:      "unmanaged load balancer is released",
+			ownership: infrav1.DOResourceUnmanaged,
+		},
+		{
+			name:       "legacy load balancer named after the cluster is deleted",
+			wantDelete: true,
+		},
+		{
+			name:   "legacy load balancer supplied by the user is released",
+			lbName: "user-lb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mlbs := mock_networking.NewMockLoadBalancersService(mctrl)
+			mtags := mock_networking.NewMockTagsService(mctrl)
+			docluster := &infrav1.DOCluster{Spec: infrav1.DOClusterSpec{Region: "nyc1"}}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+				},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{LoadBalancers: mlbs, Tags: mtags},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			networkingsvc := networking.NewService(context.TODO(), clusterScope)
+
+			lbName := tt.lbName
+			if lbName == "" {
+				lbName = networkingsvc.LoadBalancerName()
+			}
+			mlbs.EXPECT().Get(gomock.Any(), "lb-1").Return(&godo.LoadBalancer{ID: "lb-1", Name: lbName}, nil, nil)
+			if tt.wantDelete {
+				mlbs.EXPECT().Delete(gomock.Any(), "lb-1").Return(nil, nil)
+			} else {
+				mtags.EXPECT().UntagResources(gomock.Any(), networkingsvc.LoadBalancerTag(), gomock.Any()).Return(nil, nil)
+			}
+
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			ref := &infrav1.DOResourceReference{ResourceID: "lb-1", Ownership: tt.ownership}
+			g.Expect(r.deleteLoadBalancer(clusterScope, networkingsvc, ref)).To(Succeed())
+		})
+	}
+}
+
+func TestDOClusterReconciler_reconcileVPC(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before, after := created.Add(-time.Hour), created.Add(time.Minute)
+	generated := infrav1.DOVPC{IPRange: "10.10.0.0/16"}
+	named := infrav1.DOVPC{Name: "shared"}
+	tests := []struct {
+		name          string
+		spec          infrav1.DOVPC
+		ref           infrav1.DOVPCResource
+		expect        func(m *mock_networking.MockVPCsServiceMockRecorder)
+		wantOwnership infrav1.DOResourceOwnership
+	}{
+		{
+			name: "missing VPC is created",
+			spec: named,
+			expect: func(m *mock_networking.MockVPCsServiceMockRecorder) {
+				m.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{}, nil, nil)
+				m.Create(gomock.Any(), gomock.Any()).Return(&godo.VPC{ID: "vpc-1", Name: "shared", CreatedAt: after}, nil, nil)
+			},
+			wantOwnership: infrav1.DOResourceOwned,
+		},
+		{
+			name: "VPC with the generated name is recovered",
+			spec: generated,
+			expect: func(m *mock_networking.MockVPCsServiceMockRecorder) {
+				m.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-1", Name: "capdo-test-1234", RegionSlug: "nyc1", CreatedAt: before},
+				}, nil, nil)
+			},
+			wantOwnership: infrav1.DOResourceOwned,
+		},
+		{
+			name: "existing VPC with the spec name is adopted",
+			spec: named,
+			expect: func(m *mock_networking.MockVPCsServiceMockRecorder) {
+				m.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-1", Name: "shared", RegionSlug: "nyc1", CreatedAt: before},
+				}, nil, nil)
+			},
+			wantOwnership: infrav1.DOResourceUnmanaged,
+		},
+		{
+			name: "VPC with the spec name created for the cluster is recovered",
+			spec: named,
+			expect: func(m *mock_networking.MockVPCsServiceMockRecorder) {
+				m.List(gomock.Any(), gomock.Any()).Return([]*godo.VPC{
+					{ID: "vpc-1", Name: "shared", RegionSlug: "nyc1", CreatedAt: after},
+				}, nil, nil)
+			},
+			wantOwnership: infrav1.DOResourceOwned,
+		},
+		{
+			name: "recorded VPC keeps its ownership",
+			spec: named,
+			ref:  infrav1.DOVPCResource{ResourceID: "vpc-1", Ownership: infrav1.DOResourceOwned},
+			expect: func(m *mock_networking.MockVPCsServiceMockRecorder) {
+				m.Get(gomock.Any(), "vpc-1").Return(&godo.VPC{ID: "vpc-1", Name: "shared", CreatedAt: before}, nil, nil)
+			},
+			wantOwnership: infrav1.DOResourceOwned,
+		},
+		{
+			name: "recorded pre-existing VPC without ownership is adopted",
+			spec: named,
+			ref:  infrav1.DOVPCResource{ResourceID: "vpc-1"},
+			expect: func(m *mock_networking.MockVPCsServiceMockRecorder) {
+				m.Get(gomock.Any(), "vpc-1").Return(&godo.VPC{ID: "vpc-1", Name: "shared", CreatedAt: before}, nil, nil)
+			},
+			wantOwnership: infrav1.DOResourceUnmanaged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mvpcs := mock_networking.NewMockVPCsService(mctrl)
+			tt.expect(mvpcs.EXPECT())
+
+			docluster := &infrav1.DOCluster{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				Spec:       infrav1.DOClusterSpec{Region: "nyc1", Network: infrav1.DONetwork{VPC: tt.spec}},
+				Status:     infrav1.DOClusterStatus{Network: infrav1.DONetworkResource{VPC: tt.ref}},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+				},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{VPCs: mvpcs},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			g.Expect(r.reconcileVPC(clusterScope, networking.NewService(context.TODO(), clusterScope))).To(Succeed())
+			g.Expect(docluster.Status.Network.VPC.ResourceID).To(Equal("vpc-1"))
+			g.Expect(docluster.Status.Network.VPC.Ownership).To(Equal(tt.wantOwnership))
+		})
+	}
+}
+
+func TestDOClusterReconciler_reconcileDeleteVPC(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		ownership  infrav1.DOResourceOwnership
+		createdAt  time.Time
+		wantDelete bool
+	}{
+		{
+			name:       "owned VPC is deleted",
+			ownership:  infrav1.DOResourceOwned,
+			createdAt:  created.Add(-time.Hour),
+			wantDelete: true,
+		},
+		{
+			name:      "adopted VPC is left in place",
+			ownership: infrav1.DOResourceUnmanaged,
+			createdAt: created.Add(time.Minute),
+		},
+		{
+			name:       "VPC created for the cluster without ownership is deleted",
+			createdAt:  created.Add(time.Minute),
+			wantDelete: true,
+		},
+		{
+			name:      "pre-existing VPC without ownership is left in place",
+			createdAt: created.Add(-time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mvpcs := mock_networking.NewMockVPCsService(mctrl)
+			mvpcs.EXPECT().Get(gomock.Any(), "vpc-1").Return(&godo.VPC{ID: "vpc-1", Name: "shared", CreatedAt: tt.createdAt}, nil, nil)
+			if tt.wantDelete {
+				mvpcs.EXPECT().ListMembers(gomock.Any(), "vpc-1", gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+				mvpcs.EXPECT().Delete(gomock.Any(), "vpc-1").Return(nil, nil)
+			}
+
+			docluster := &infrav1.DOCluster{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				Spec:       infrav1.DOClusterSpec{Region: "nyc1", Network: infrav1.DONetwork{VPC: infrav1.DOVPC{Name: "shared"}}},
+				Status: infrav1.DOClusterStatus{Network: infrav1.DONetworkResource{
+					VPC: infrav1.DOVPCResource{ResourceID: "vpc-1", Ownership: tt.ownership},
+				}},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", UID: types.UID("1234")},
+				},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{VPCs: mvpcs},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			result, err := r.reconcileDeleteVPC(clusterScope, networking.NewService(context.TODO(), clusterScope))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result.IsZero()).To(BeTrue())
+			g.Expect(docluster.Status.Network.VPC.ResourceID).To(BeEmpty())
+		})
+	}
+}
+
+func TestDOClusterReconciler_reconcileProject(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notFound := &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	tests := []struct {
+		name          string
+		project       *infrav1.DOProject
+		recordedID    string
+		expect        func(mp *mock_projects.MockProjectsServiceMockRecorder)
+		wantProjectID string
+		wantErr       bool
+	}{
+		{
+			name:       "no project clears the recorded one",
+			recordedID: "p-1",
+			expect:     func(_ *mock_projects.MockProjectsServiceMockRecorder) {},
+		},
+		{
+			name:    "project referenced by ID",
+			project: &infrav1.DOProject{ID: "p-1"},
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.Get(gomock.Any(), "p-1").Return(&godo.Project{ID: "p-1", Name: "team"}, nil, nil)
+			},
+			wantProjectID: "p-1",
+		},
+		{
+			name:    "project referenced by ID not found",
+			project: &infrav1.DOProject{ID: "p-1"},
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.Get(gomock.Any(), "p-1").Return(nil, notFound, errors.New("not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name:       "recorded project referenced by name",
+			project:    &infrav1.DOProject{Name: "team"},
+			recordedID: "p-1",
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.Get(gomock.Any(), "p-1").Return(&godo.Project{ID: "p-1", Name: "team"}, nil, nil)
+			},
+			wantProjectID: "p-1",
+		},
+		{
+			name:       "renamed project is looked up by name again",
+			project:    &infrav1.DOProject{Name: "team"},
+			recordedID: "p-1",
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.Get(gomock.Any(), "p-1").Return(&godo.Project{ID: "p-1", Name: "other"}, nil, nil)
+				mp.List(gomock.Any(), gomock.Any()).Return([]godo.Project{{ID: "p-1", Name: "other"}, {ID: "p-2", Name: "team"}}, nil, nil)
+			},
+			wantProjectID: "p-2",
+		},
+		{
+			name:    "missing project is created",
+			project: &infrav1.DOProject{Name: "team", Create: true},
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.List(gomock.Any(), gomock.Any()).Return([]godo.Project{}, nil, nil)
+				mp.Create(gomock.Any(), gomock.Any()).Return(&godo.Project{ID: "p-3", Name: "team"}, nil, nil)
+			},
+			wantProjectID: "p-3",
+		},
+		{
+			name:    "missing project is not created",
+			project: &infrav1.DOProject{Name: "team"},
+			expect: func(mp *mock_projects.MockProjectsServiceMockRecorder) {
+				mp.List(gomock.Any(), gomock.Any()).Return([]godo.Project{}, nil, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mctrl := gomock.NewController(t)
+
+			mprojects := mock_projects.NewMockProjectsService(mctrl)
+			tt.expect(mprojects.EXPECT())
+
+			docluster := &infrav1.DOCluster{
+				Spec:   infrav1.DOClusterSpec{Project: tt.project},
+				Status: infrav1.DOClusterStatus{ProjectID: tt.recordedID},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster:   &clusterv1beta2.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capdo-test"}},
+				DOCluster: docluster,
+				DOClients: scope.DOClients{Projects: mprojects},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			err = r.reconcileProject(clusterScope, projects.NewService(context.TODO(), clusterScope))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(docluster.Status.ProjectID).To(Equal(tt.wantProjectID))
+		})
+	}
+}
+
+func TestSetControlPlaneDNSPropagatedCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	docluster := &infrav1.DOCluster{}
+	clusterScope := &scope.ClusterScope{DOCluster: docluster}
+
+	setControlPlaneDNSPropagatedCondition(clusterScope, metav1.ConditionFalse, infrav1.ControlPlaneDNSNotPropagatedReason, "A: 1/3 nameservers")
+	first := docluster.Status.Conditions[0]
+	g.Expect(docluster.Status.ControlPlaneDNSLastCheckTime).NotTo(BeNil())
+	docluster.Status.ControlPlaneDNSLastCheckTime = nil
+	setControlPlaneDNSPropagatedCondition(clusterScope, metav1.ConditionFalse, infrav1.ControlPlaneDNSNotPropagatedReason, "A: 1/3 nameservers")
+
+	// An unchanged result only records the time of the check.
+	g.Expect(docluster.Status.Conditions).To(Equal([]metav1.Condition{first}))
+	g.Expect(first.Message).To(Equal("A: 1/3 nameservers"))
+	g.Expect(docluster.Status.ControlPlaneDNSLastCheckTime).NotTo(BeNil())
+}
+
+func TestDOClusterChanged(t *testing.T) {
+	old := &infrav1.DOCluster{ObjectMeta: metav1.ObjectMeta{Name: "capdo-test", Generation: 1}}
+	statusOnly := old.DeepCopy()
+	statusOnly.Status.ControlPlaneDNSRecordReady = true
+	specChanged := old.DeepCopy()
+	specChanged.Generation = 2
+	paused := old.DeepCopy()
+	paused.Annotations = map[string]string{clusterv1beta2.PausedAnnotation: "true"}
+	owned := old.DeepCopy()
+	owned.OwnerReferences = []metav1.OwnerReference{{Kind: "Cluster", Name: "capdo-test"}}
+
+	tests := []struct {
+		name string
+		new  *infrav1.DOCluster
+		want bool
+	}{
+		{name: "status update", new: statusOnly, want: false},
+		{name: "spec update", new: specChanged, want: true},
+		{name: "annotation update", new: paused, want: true},
+		{name: "owner reference update", new: owned, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(doClusterChanged().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: tt.new})).To(Equal(tt.want))
+		})
+	}
+}
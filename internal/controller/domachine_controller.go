@@ -23,16 +23,19 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/digitalocean/godo"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/projects"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/util/reconciler"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	capierrors "sigs.k8s.io/cluster-api/errors" //nolint:staticcheck
@@ -48,12 +51,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const (
+	// maxPowerOnAttempts is the number of times a powered off droplet is
+	// powered back on before the machine is marked as failed.
+	maxPowerOnAttempts = 3
+	// powerOnRetryInterval is the time given to a power on action to bring
+	// the droplet back before another attempt is made.
+	powerOnRetryInterval = time.Minute
+)
+
 // DOMachineReconciler reconciles a DOMachine object.
 type DOMachineReconciler struct {
 	client.Client
 	Recorder           record.EventRecorder
 	ReconcileTimeout   time.Duration
 	EnableAntiAffinity bool
+	// ResyncInterval is the interval at which the droplets of ready machines
+	// are observed again. Zero disables the periodic observation.
+	ResyncInterval time.Duration
 }
 
 func (r *DOMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -225,7 +240,7 @@ func (r *DOMachineReconciler) reconcileVolumes(ctx context.Context, mscope *scop
 			return reconcile.Result{}, err
 		}
 		if vol == nil {
-			_, err = computesvc.CreateVolume(disk, volName)
+			_, err = computesvc.CreateVolume(mscope, disk, volName)
 			if err != nil {
 				return reconcile.Result{}, err
 			}
@@ -239,7 +254,8 @@ func (r *DOMachineReconciler) reconcile(ctx context.Context, machineScope *scope
 	machineScope.Info("Reconciling DOMachine")
 	domachine := machineScope.DOMachine
 	// If the DOMachine is in an error state, return early.
-	if domachine.Status.FailureReason != nil || domachine.Status.FailureMessage != nil {
+	if deprecated := domachine.Status.Deprecated; deprecated != nil && deprecated.V1Beta1 != nil &&
+		(deprecated.V1Beta1.FailureReason != nil || deprecated.V1Beta1.FailureMessage != nil) {
 		machineScope.Info("Error state detected, skipping reconciliation")
 		return reconcile.Result{}, nil
 	}
@@ -249,39 +265,48 @@ func (r *DOMachineReconciler) reconcile(ctx context.Context, machineScope *scope
 
 	if !ptr.Deref(machineScope.Cluster.Status.Initialization.InfrastructureProvisioned, false) {
 		machineScope.Info("Cluster infrastructure is not provisioned yet")
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.WaitingForClusterInfrastructureReason, "")
 		return reconcile.Result{}, nil
 	}
 
 	// Make sure bootstrap data is available and populated.
 	if machineScope.Machine.Spec.Bootstrap.DataSecretName == nil {
 		machineScope.Info("Bootstrap data secret reference is not yet available")
+		setCondition(domachine, infrav1.BootstrapDataReadyCondition, metav1.ConditionFalse, infrav1.WaitingForBootstrapDataReason, "")
 		return reconcile.Result{}, nil
 	}
+	setCondition(domachine, infrav1.BootstrapDataReadyCondition, metav1.ConditionTrue, infrav1.ReadyReason, "")
 
 	// Make sure the droplet volumes are reconciled
 	if result, err := r.reconcileVolumes(ctx, machineScope, clusterScope); err != nil {
+		setCondition(domachine, infrav1.VolumesReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
 		return result, fmt.Errorf("failed to reconcile volumes: %w", err)
 	}
+	setCondition(domachine, infrav1.VolumesReadyCondition, metav1.ConditionTrue, infrav1.ReadyReason, "")
 
-	// Once a droplet is marked active, it should never switch to a different
-	// state again (other than archived, which is handled by the delete
-	// reconciler). Return early in this case to skip doing unnecessary API
-	// requests.
-	if machineScope.IsReady() {
-		return reconcile.Result{}, nil
-	}
-
+	// Ready machines are observed again every resync interval, so that
+	// droplets changed or deleted out of band are noticed.
 	computesvc := computes.NewService(ctx, clusterScope)
 	droplet, err := computesvc.GetDroplet(machineScope.GetInstanceID())
 	if err != nil {
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
 		return reconcile.Result{}, err
 	}
+	if droplet == nil && machineScope.IsReady() {
+		err := errors.Errorf("Droplet %s was deleted out of band", machineScope.GetInstanceID())
+		r.Recorder.Event(domachine, corev1.EventTypeWarning, "InstanceNotFound", err.Error())
+		machineScope.SetFailureReason(capierrors.UpdateMachineError)
+		machineScope.SetFailureMessage(err)
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.InstanceNotFoundReason, err.Error())
+		return reconcile.Result{}, nil
+	}
 	if droplet == nil {
 		droplet, err = computesvc.CreateDroplet(machineScope)
 		if err != nil {
 			err = errors.Errorf("Failed to create droplet instance for DOMachine %s/%s: %v", domachine.Namespace, domachine.Name, err)
 			r.Recorder.Event(domachine, corev1.EventTypeWarning, "InstanceCreatingError", err.Error())
 			machineScope.SetInstanceStatus(infrav1.DOResourceStatusErrored)
+			setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
 			return reconcile.Result{}, err
 		}
 		r.Recorder.Eventf(domachine, corev1.EventTypeNormal, "InstanceCreated", "Created new droplet instance - %s", droplet.Name)
@@ -294,26 +319,107 @@ func (r *DOMachineReconciler) reconcile(ctx context.Context, machineScope *scope
 	addrs, err := computesvc.GetDropletAddress(droplet)
 	if err != nil {
 		machineScope.SetFailureMessage(errors.New("failed to getting droplet address"))
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
 		return reconcile.Result{}, err
 	}
 	machineScope.SetAddresses(addrs)
 
+	if err := computesvc.ReconcileDropletTags(machineScope, droplet); err != nil {
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile droplet tags: %w", err)
+	}
+
+	if err := r.reconcileProjectResources(ctx, machineScope, clusterScope, computesvc, droplet); err != nil {
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile project resources: %w", err)
+	}
+
 	// Proceed to reconcile the DOMachine state.
 	switch infrav1.DOResourceStatus(droplet.Status) {
 	case infrav1.DOResourceStatusNew:
 		machineScope.Info("Machine instance is pending", "instance-id", machineScope.GetInstanceID())
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.InstanceProvisioningReason,
+			fmt.Sprintf("Droplet %s is being created", machineScope.GetInstanceID()))
 		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
 	case infrav1.DOResourceStatusRunning:
 		machineScope.Info("Machine instance is active", "instance-id", machineScope.GetInstanceID())
-		machineScope.SetReady()
-		r.Recorder.Eventf(domachine, corev1.EventTypeNormal, "DOMachineReady", "DOMachine %s - has ready status", droplet.Name)
-		return reconcile.Result{}, nil
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionTrue, infrav1.ReadyReason, "")
+		machineScope.SetPowerOnStatus(nil)
+		if !machineScope.IsReady() {
+			machineScope.SetReady()
+			r.Recorder.Eventf(domachine, corev1.EventTypeNormal, "DOMachineReady", "DOMachine %s - has ready status", droplet.Name)
+		}
+		return reconcile.Result{RequeueAfter: r.ResyncInterval}, nil
+	case infrav1.DOResourceStatusOff:
+		return r.reconcilePoweredOffDroplet(machineScope, computesvc, droplet)
 	default:
 		machineScope.SetFailureReason(capierrors.UpdateMachineError)
 		machineScope.SetFailureMessage(errors.Errorf("Instance status %q is unexpected", droplet.Status))
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.InstanceUnexpectedStatusReason,
+			fmt.Sprintf("Instance status %q is unexpected", droplet.Status))
 		return reconcile.Result{}, nil
 	}
 }
+
+// reconcileProjectResources assigns the droplet and its data disk volumes to
+// the project of the cluster, if any.
+func (r *DOMachineReconciler) reconcileProjectResources(ctx context.Context, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope, computesvc *computes.Service, droplet *godo.Droplet) error {
+	projectID := clusterScope.ProjectID()
+	if projectID == "" {
+		return nil
+	}
+
+	resources := []godo.ResourceWithURN{*droplet}
+	for _, disk := range machineScope.DOMachine.Spec.DataDisks {
+		vol, err := computesvc.GetVolumeByName(infrav1.DataDiskName(machineScope.DOMachine, disk.NameSuffix))
+		if err != nil {
+			return err
+		}
+		if vol != nil {
+			resources = append(resources, *vol)
+		}
+	}
+
+	return projects.NewService(ctx, clusterScope).AssignResources(projectID, resources...)
+}
+
+// reconcilePoweredOffDroplet powers a droplet found off back on, giving each
+// power on action powerOnRetryInterval to complete. The machine is only marked
+// as failed once maxPowerOnAttempts were made without the droplet coming back.
+func (r *DOMachineReconciler) reconcilePoweredOffDroplet(machineScope *scope.MachineScope, computesvc *computes.Service, droplet *godo.Droplet) (reconcile.Result, error) {
+	domachine := machineScope.DOMachine
+	var attempts int32
+	if powerOn := machineScope.PowerOnStatus(); powerOn != nil {
+		attempts = powerOn.Attempts
+		if powerOn.LastAttemptTime != nil {
+			if wait := powerOnRetryInterval - time.Since(powerOn.LastAttemptTime.Time); wait > 0 {
+				machineScope.Info("Waiting for machine instance to be powered on", "instance-id", machineScope.GetInstanceID(), "attempts", attempts)
+				return reconcile.Result{RequeueAfter: wait}, nil
+			}
+		}
+	}
+
+	if attempts >= maxPowerOnAttempts {
+		err := errors.Errorf("Instance is still off after %d power on attempts", attempts)
+		r.Recorder.Event(domachine, corev1.EventTypeWarning, "InstancePowerOnFailed", err.Error())
+		machineScope.SetFailureReason(capierrors.UpdateMachineError)
+		machineScope.SetFailureMessage(err)
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.InstanceUnexpectedStatusReason, err.Error())
+		return reconcile.Result{}, nil
+	}
+
+	if err := computesvc.PowerOnDroplet(droplet.ID); err != nil {
+		setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.ReconciliationFailedReason, err.Error())
+		return reconcile.Result{}, err
+	}
+	attempts++
+	machineScope.SetPowerOnStatus(&infrav1.DOPowerOnStatus{Attempts: attempts, LastAttemptTime: ptr.To(metav1.Now())})
+	r.Recorder.Eventf(domachine, corev1.EventTypeNormal, "InstancePoweringOn", "Powering on droplet %s, attempt %d of %d", droplet.Name, attempts, maxPowerOnAttempts)
+	setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.InstancePoweringOnReason,
+		fmt.Sprintf("Droplet %s was found off, power on attempt %d of %d", machineScope.GetInstanceID(), attempts, maxPowerOnAttempts))
+	return reconcile.Result{RequeueAfter: powerOnRetryInterval}, nil
+}
+
 func (r *DOMachineReconciler) reconcileDeleteVolumes(ctx context.Context, mscope *scope.MachineScope, cscope *scope.ClusterScope) (reconcile.Result, error) { //nolint: unparam
 	mscope.Info("Reconciling delete DOMachine Volumes")
 	computesvc := computes.NewService(ctx, cscope)
@@ -338,6 +444,7 @@ func (r *DOMachineReconciler) reconcileDeleteVolumes(ctx context.Context, mscope
 func (r *DOMachineReconciler) reconcileDelete(ctx context.Context, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
 	machineScope.Info("Reconciling delete DOMachine")
 	domachine := machineScope.DOMachine
+	setCondition(domachine, infrav1.InstanceReadyCondition, metav1.ConditionFalse, infrav1.DeletingReason, "")
 
 	computesvc := computes.NewService(ctx, clusterScope)
 	droplet, err := computesvc.GetDroplet(machineScope.GetInstanceID())
@@ -18,21 +18,33 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/digitalocean/godo"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes/mock_computes"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computesenhanced/mock_computesenhanced"
 )
 
 var (
@@ -157,3 +169,282 @@ func TestDOMachineReconciler_DOClusterToDOMachines(t *testing.T) {
 		})
 	}
 }
+
+func TestDOMachineReconciler_reconcileConditions(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                string
+		infrastructureReady bool
+		wantConditionType   string
+		wantConditionReason string
+	}{
+		{
+			name:                "waiting for the cluster infrastructure",
+			wantConditionType:   infrav1.InstanceReadyCondition,
+			wantConditionReason: infrav1.WaitingForClusterInfrastructureReason,
+		},
+		{
+			name:                "waiting for the bootstrap data",
+			infrastructureReady: true,
+			wantConditionType:   infrav1.BootstrapDataReadyCondition,
+			wantConditionReason: infrav1.WaitingForBootstrapDataReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := newCluster("capdo-test")
+			cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(tt.infrastructureReady)
+			domachine := &infrav1.DOMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "capdo-test-0", Namespace: namespace},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(domachine).WithStatusSubresource(domachine).Build()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:    c,
+				Cluster:   cluster,
+				DOCluster: &infrav1.DOCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:    c,
+				Cluster:   cluster,
+				Machine:   newMachine("capdo-test", "capdo-test-0"),
+				DOCluster: &infrav1.DOCluster{},
+				DOMachine: domachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOMachineReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+			_, err = r.reconcile(context.TODO(), machineScope, clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(machineScope.Close()).To(Succeed())
+
+			got := &infrav1.DOMachine{}
+			g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(domachine), got)).To(Succeed())
+			g.Expect(conditions.IsFalse(got, tt.wantConditionType)).To(BeTrue())
+			g.Expect(conditions.GetReason(got, tt.wantConditionType)).To(Equal(tt.wantConditionReason))
+			g.Expect(conditions.IsTrue(got, infrav1.ReadyCondition)).To(BeFalse())
+		})
+	}
+}
+
+func TestDOMachineReconciler_reconcilePoweredOffDroplet(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	longAgo := metav1.NewTime(time.Now().Add(-2 * powerOnRetryInterval))
+	tests := []struct {
+		name         string
+		powerOn      *infrav1.DOPowerOnStatus
+		expect       func(m *mock_computes.MockDropletActionsServiceMockRecorder)
+		wantErr      bool
+		wantAttempts int32
+		wantReason   string
+		wantFailed   bool
+	}{
+		{
+			name: "first power on attempt",
+			expect: func(m *mock_computes.MockDropletActionsServiceMockRecorder) {
+				m.PowerOn(gomock.Any(), 1234).Return(&godo.Action{}, nil, nil)
+			},
+			wantAttempts: 1,
+			wantReason:   infrav1.InstancePoweringOnReason,
+		},
+		{
+			name:         "previous attempt still in progress",
+			powerOn:      &infrav1.DOPowerOnStatus{Attempts: 1, LastAttemptTime: ptr.To(metav1.Now())},
+			wantAttempts: 1,
+		},
+		{
+			name:    "retry after the previous attempt did not succeed",
+			powerOn: &infrav1.DOPowerOnStatus{Attempts: 1, LastAttemptTime: &longAgo},
+			expect: func(m *mock_computes.MockDropletActionsServiceMockRecorder) {
+				m.PowerOn(gomock.Any(), 1234).Return(&godo.Action{}, nil, nil)
+			},
+			wantAttempts: 2,
+			wantReason:   infrav1.InstancePoweringOnReason,
+		},
+		{
+			name:    "power on request fails",
+			powerOn: &infrav1.DOPowerOnStatus{Attempts: 1, LastAttemptTime: &longAgo},
+			expect: func(m *mock_computes.MockDropletActionsServiceMockRecorder) {
+				m.PowerOn(gomock.Any(), 1234).Return(nil, nil, errors.New("rate limited"))
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+			wantReason:   infrav1.ReconciliationFailedReason,
+		},
+		{
+			name:         "retries exhausted",
+			powerOn:      &infrav1.DOPowerOnStatus{Attempts: maxPowerOnAttempts, LastAttemptTime: &longAgo},
+			wantAttempts: maxPowerOnAttempts,
+			wantReason:   infrav1.InstanceUnexpectedStatusReason,
+			wantFailed:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			dropletActions := mock_computes.NewMockDropletActionsService(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(dropletActions.EXPECT())
+			}
+
+			cluster := newCluster("capdo-test")
+			domachine := &infrav1.DOMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "capdo-test-0", Namespace: namespace},
+				Spec:       infrav1.DOMachineSpec{ProviderID: ptr.To("digitalocean://1234")},
+				Status:     infrav1.DOMachineStatus{PowerOn: tt.powerOn},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(domachine).Build()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				DOClients: scope.DOClients{DropletActions: dropletActions},
+				Client:    c,
+				Cluster:   cluster,
+				DOCluster: &infrav1.DOCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:    c,
+				Cluster:   cluster,
+				Machine:   newMachine("capdo-test", "capdo-test-0"),
+				DOCluster: &infrav1.DOCluster{},
+				DOMachine: domachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOMachineReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+			computesvc := computes.NewService(context.TODO(), clusterScope)
+			result, err := r.reconcilePoweredOffDroplet(machineScope, computesvc, &godo.Droplet{ID: 1234, Name: "capdo-test-0", Status: "off"})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			g.Expect(domachine.Status.PowerOn.Attempts).To(Equal(tt.wantAttempts))
+			if tt.wantReason != "" {
+				g.Expect(conditions.GetReason(domachine, infrav1.InstanceReadyCondition)).To(Equal(tt.wantReason))
+			}
+			g.Expect(domachine.Status.Deprecated != nil).To(Equal(tt.wantFailed))
+			g.Expect(result.RequeueAfter > 0).To(Equal(!tt.wantErr && !tt.wantFailed))
+		})
+	}
+}
+
+func TestDOMachineReconciler_reconcileReadyMachine(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		droplet       *godo.Droplet
+		wantAddresses []corev1.NodeAddress
+		wantReason    string
+		wantFailed    bool
+		wantRequeue   bool
+	}{
+		{
+			name: "addresses changed out of band",
+			droplet: &godo.Droplet{
+				ID:     1234,
+				Status: "active",
+				Networks: &godo.Networks{V4: []godo.NetworkV4{
+					{IPAddress: "10.0.0.3", Type: "private"},
+					{IPAddress: "203.0.113.3", Type: "public"},
+				}},
+			},
+			wantAddresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.3"},
+				{Type: corev1.NodeExternalIP, Address: "203.0.113.3"},
+			},
+			wantReason:  infrav1.ReadyReason,
+			wantRequeue: true,
+		},
+		{
+			name: "droplet deleted out of band",
+			wantAddresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+				{Type: corev1.NodeExternalIP, Address: "203.0.113.2"},
+			},
+			wantReason: infrav1.InstanceNotFoundReason,
+			wantFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			droplets := mock_computesenhanced.NewMockDropletsService(mockCtrl)
+			droplets.EXPECT().Get(gomock.Any(), 1234).Return(tt.droplet, nil, nil)
+
+			cluster := newCluster("capdo-test")
+			cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+			machine := newMachine("capdo-test", "capdo-test-0")
+			machine.Spec.Bootstrap.DataSecretName = ptr.To("capdo-test-0-bootstrap")
+			domachine := &infrav1.DOMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "capdo-test-0", Namespace: namespace},
+				Spec:       infrav1.DOMachineSpec{ProviderID: ptr.To("digitalocean://1234")},
+				Status: infrav1.DOMachineStatus{
+					Initialization: &infrav1.DOMachineInitializationStatus{Provisioned: ptr.To(true)},
+					Addresses: []corev1.NodeAddress{
+						{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+						{Type: corev1.NodeExternalIP, Address: "203.0.113.2"},
+					},
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(domachine).Build()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				DOClients: scope.DOClients{Droplets: droplets},
+				Client:    c,
+				Cluster:   cluster,
+				DOCluster: &infrav1.DOCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:    c,
+				Cluster:   cluster,
+				Machine:   machine,
+				DOCluster: &infrav1.DOCluster{},
+				DOMachine: domachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DOMachineReconciler{Client: c, Recorder: record.NewFakeRecorder(10), ResyncInterval: 5 * time.Minute}
+			result, err := r.reconcile(context.TODO(), machineScope, clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(domachine.Status.Addresses).To(Equal(tt.wantAddresses))
+			g.Expect(conditions.GetReason(domachine, infrav1.InstanceReadyCondition)).To(Equal(tt.wantReason))
+			g.Expect(domachine.Status.Deprecated != nil).To(Equal(tt.wantFailed))
+			g.Expect(machineScope.IsReady()).To(BeTrue())
+			g.Expect(result.RequeueAfter == r.ResyncInterval).To(Equal(tt.wantRequeue))
+		})
+	}
+}
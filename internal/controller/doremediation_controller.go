@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/util/reconciler"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DORemediationReconciler reconciles a DORemediation object. It remediates
+// the unhealthy Machine of the same name by escalating from a droplet power
+// cycle to a droplet rebuild, and finally to the deletion of the Machine.
+type DORemediationReconciler struct {
+	client.Client
+	Recorder         record.EventRecorder
+	ReconcileTimeout time.Duration
+}
+
+func (r *DORemediationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1.DORemediation{}).
+		WithEventFilter(predicates.ResourceNotPaused(mgr.GetScheme(), ctrl.LoggerFrom(ctx))).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=doremediations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=doremediations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=doremediationtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+
+func (r *DORemediationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx)
+
+	doRemediation := &infrav1.DORemediation{}
+	if err := r.Get(ctx, req.NamespacedName, doRemediation); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !doRemediation.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	// The MachineHealthCheck names the remediation after the unhealthy Machine.
+	machine := &clusterv1beta2.Machine{}
+	if err := r.Get(ctx, req.NamespacedName, machine); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Machine to remediate does not exist")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		log.Info("Machine is missing cluster label or cluster does not exist")
+		return reconcile.Result{}, nil
+	}
+
+	doCluster := &infrav1.DOCluster{}
+	doClusterNamespacedName := client.ObjectKey{
+		Namespace: machine.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Get(ctx, doClusterNamespacedName, doCluster); err != nil {
+		log.Info("DOCluster is not available yet")
+		return reconcile.Result{}, nil
+	}
+
+	// Return early if the object or Cluster is paused.
+	if annotations.IsPaused(cluster, doRemediation) {
+		log.Info("DORemediation or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	doMachine := &infrav1.DOMachine{}
+	doMachineNamespacedName := client.ObjectKey{
+		Namespace: machine.Namespace,
+		Name:      machine.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Get(ctx, doMachineNamespacedName, doMachine); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("DOMachine to remediate does not exist")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:    r.Client,
+		Logger:    log,
+		Cluster:   cluster,
+		DOCluster: doCluster,
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Create the remediation scope
+	remediationScope, err := scope.NewRemediationScope(scope.RemediationScopeParams{
+		Client:        r.Client,
+		Logger:        log,
+		Machine:       machine,
+		DOMachine:     doMachine,
+		DORemediation: doRemediation,
+	})
+	if err != nil {
+		return reconcile.Result{}, errors.Errorf("failed to create scope: %+v", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any DORemediation changes.
+	defer func() {
+		if err := remediationScope.Close(); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	return r.reconcile(ctx, remediationScope, clusterScope)
+}
+
+func (r *DORemediationReconciler) reconcile(ctx context.Context, remediationScope *scope.RemediationScope, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
+	remediationScope.Info("Reconciling DORemediation", "phase", remediationScope.Phase())
+
+	// The MachineHealthCheck deletes the DORemediation once the Machine is
+	// healthy again, so each step only has to wait for its timeout.
+	if wait := remediationScope.StepTimeLeft(); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	computesvc := computes.NewService(ctx, clusterScope)
+	switch remediationScope.Phase() {
+	case "":
+		return r.powerCycle(ctx, remediationScope, computesvc)
+	case infrav1.DORemediationPhasePowerCycling:
+		return r.rebuild(ctx, remediationScope, computesvc)
+	case infrav1.DORemediationPhaseRebuilding:
+		return reconcile.Result{}, r.deleteMachine(ctx, remediationScope, "the droplet rebuild timed out")
+	default:
+		return reconcile.Result{}, nil
+	}
+}
+
+// powerCycle power cycles the droplet of the remediated machine.
+func (r *DORemediationReconciler) powerCycle(ctx context.Context, remediationScope *scope.RemediationScope, computesvc *computes.Service) (reconcile.Result, error) {
+	dropletID, ok, err := r.dropletID(remediationScope, computesvc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !ok {
+		return reconcile.Result{}, r.deleteMachine(ctx, remediationScope, "the droplet does not exist")
+	}
+
+	if err := computesvc.PowerCycleDroplet(dropletID); err != nil {
+		r.Recorder.Eventf(remediationScope.DORemediation, corev1.EventTypeWarning, "PowerCycleFailed", "Failed to power cycle droplet %d: %v", dropletID, err)
+		return reconcile.Result{}, err
+	}
+	remediationScope.SetPhase(infrav1.DORemediationPhasePowerCycling)
+	r.Recorder.Eventf(remediationScope.DORemediation, corev1.EventTypeNormal, "PowerCycling", "Power cycling droplet %d of Machine %s", dropletID, remediationScope.Machine.Name)
+	return reconcile.Result{RequeueAfter: remediationScope.StepTimeLeft()}, nil
+}
+
+// rebuild rebuilds the droplet of the remediated machine from the machine
+// image, keeping its IP addresses and volumes.
+func (r *DORemediationReconciler) rebuild(ctx context.Context, remediationScope *scope.RemediationScope, computesvc *computes.Service) (reconcile.Result, error) {
+	dropletID, ok, err := r.dropletID(remediationScope, computesvc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !ok {
+		return reconcile.Result{}, r.deleteMachine(ctx, remediationScope, "the droplet does not exist")
+	}
+
+	if err := computesvc.RebuildDroplet(dropletID, remediationScope.DOMachine.Spec.Image); err != nil {
+		r.Recorder.Eventf(remediationScope.DORemediation, corev1.EventTypeWarning, "RebuildFailed", "Failed to rebuild droplet %d: %v", dropletID, err)
+		return reconcile.Result{}, err
+	}
+	remediationScope.SetPhase(infrav1.DORemediationPhaseRebuilding)
+	r.Recorder.Eventf(remediationScope.DORemediation, corev1.EventTypeNormal, "Rebuilding", "Power cycle timed out, rebuilding droplet %d of Machine %s", dropletID, remediationScope.Machine.Name)
+	return reconcile.Result{RequeueAfter: remediationScope.StepTimeLeft()}, nil
+}
+
+// deleteMachine deletes the remediated machine, leaving its replacement to
+// the Machine owner.
+func (r *DORemediationReconciler) deleteMachine(ctx context.Context, remediationScope *scope.RemediationScope, reason string) error {
+	if err := r.Delete(ctx, remediationScope.Machine); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete Machine %s", remediationScope.Machine.Name)
+	}
+	remediationScope.SetPhase(infrav1.DORemediationPhaseDeleting)
+	r.Recorder.Eventf(remediationScope.DORemediation, corev1.EventTypeNormal, "MachineDeleted", "Deleted Machine %s as %s", remediationScope.Machine.Name, reason)
+	return nil
+}
+
+// dropletID returns the id of the droplet of the remediated machine, and
+// whether that droplet exists.
+func (r *DORemediationReconciler) dropletID(remediationScope *scope.RemediationScope, computesvc *computes.Service) (int, bool, error) {
+	droplet, err := computesvc.GetDroplet(remediationScope.GetInstanceID())
+	if err != nil || droplet == nil {
+		return 0, false, err
+	}
+	return droplet.ID, true, nil
+}
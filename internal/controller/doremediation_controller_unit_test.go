@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computes/mock_computes"
+	"sigs.k8s.io/cluster-api-provider-digitalocean/cloud/services/computesenhanced/mock_computesenhanced"
+)
+
+func TestDORemediationReconciler_reconcile(t *testing.T) {
+	os.Setenv("DIGITALOCEAN_ACCESS_TOKEN", "super-secret-token")
+	defer os.Unsetenv("DIGITALOCEAN_ACCESS_TOKEN") //nolint:errcheck
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timedOut := metav1.NewTime(time.Now().Add(-time.Hour))
+	tests := []struct {
+		name        string
+		status      infrav1.DORemediationStatus
+		droplet     *godo.Droplet
+		expect      func(m *mock_computes.MockDropletActionsServiceMockRecorder)
+		wantPhase   infrav1.DORemediationPhase
+		wantRequeue bool
+		wantDeleted bool
+	}{
+		{
+			name:    "power cycle the droplet first",
+			droplet: &godo.Droplet{ID: 1234},
+			expect: func(m *mock_computes.MockDropletActionsServiceMockRecorder) {
+				m.PowerCycle(gomock.Any(), 1234).Return(&godo.Action{}, nil, nil)
+			},
+			wantPhase:   infrav1.DORemediationPhasePowerCycling,
+			wantRequeue: true,
+		},
+		{
+			name:        "wait for the power cycle timeout",
+			status:      infrav1.DORemediationStatus{Phase: infrav1.DORemediationPhasePowerCycling, LastStepTime: ptr.To(metav1.Now())},
+			wantPhase:   infrav1.DORemediationPhasePowerCycling,
+			wantRequeue: true,
+		},
+		{
+			name:    "rebuild the droplet once the power cycle timed out",
+			status:  infrav1.DORemediationStatus{Phase: infrav1.DORemediationPhasePowerCycling, LastStepTime: &timedOut},
+			droplet: &godo.Droplet{ID: 1234},
+			expect: func(m *mock_computes.MockDropletActionsServiceMockRecorder) {
+				m.RebuildByImageID(gomock.Any(), 1234, 5678).Return(&godo.Action{}, nil, nil)
+			},
+			wantPhase:   infrav1.DORemediationPhaseRebuilding,
+			wantRequeue: true,
+		},
+		{
+			name:        "delete the machine once the rebuild timed out",
+			status:      infrav1.DORemediationStatus{Phase: infrav1.DORemediationPhaseRebuilding, LastStepTime: &timedOut},
+			wantPhase:   infrav1.DORemediationPhaseDeleting,
+			wantDeleted: true,
+		},
+		{
+			name:        "delete the machine when its droplet is gone",
+			wantPhase:   infrav1.DORemediationPhaseDeleting,
+			wantDeleted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			droplets := mock_computesenhanced.NewMockDropletsService(mockCtrl)
+			droplets.EXPECT().Get(gomock.Any(), 1234).Return(tt.droplet, nil, nil).AnyTimes()
+			dropletActions := mock_computes.NewMockDropletActionsService(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(dropletActions.EXPECT())
+			}
+
+			cluster := newCluster("capdo-test")
+			machine := newMachine("capdo-test", "capdo-test-0")
+			domachine := &infrav1.DOMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "capdo-test-0", Namespace: namespace},
+				Spec: infrav1.DOMachineSpec{
+					ProviderID: ptr.To("digitalocean://1234"),
+					Image:      intstr.FromInt(5678),
+				},
+			}
+			doremediation := &infrav1.DORemediation{
+				ObjectMeta: metav1.ObjectMeta{Name: "capdo-test-0", Namespace: namespace},
+				Status:     tt.status,
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, doremediation).Build()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				DOClients: scope.DOClients{Droplets: droplets, DropletActions: dropletActions},
+				Client:    c,
+				Cluster:   cluster,
+				DOCluster: &infrav1.DOCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			remediationScope, err := scope.NewRemediationScope(scope.RemediationScopeParams{
+				Client:        c,
+				Machine:       machine,
+				DOMachine:     domachine,
+				DORemediation: doremediation,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &DORemediationReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+			result, err := r.reconcile(context.TODO(), remediationScope, clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result.RequeueAfter > 0).To(Equal(tt.wantRequeue))
+			g.Expect(doremediation.Status.Phase).To(Equal(tt.wantPhase))
+
+			err = c.Get(context.TODO(), client.ObjectKeyFromObject(machine), machine)
+			g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.wantDeleted))
+		})
+	}
+}
@@ -42,7 +42,7 @@ import (
 	"sigs.k8s.io/cluster-api/test/framework/ginkgoextensions"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-digitalocean/api/v1beta2"
 )
 
 // Test suite flags.
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apiserver implements health checks of the Kubernetes API server.
+package apiserver
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultHealthCheckTimeout is the default timeout of a single API server health check.
+const DefaultHealthCheckTimeout = 5 * time.Second
+
+// HealthChecker checks whether the API server listening on the given host and port is healthy.
+type HealthChecker func(ctx context.Context, host string, port int32) bool
+
+// CheckHealth returns true if the API server listening on the given host and
+// port reports itself as ready. The readyz endpoint is anonymously readable by
+// default, and the serving certificate is not verified since the droplet
+// address is usually not part of its SANs.
+func CheckHealth(ctx context.Context, host string, port int32) bool {
+	ctx, cancel := context.WithTimeout(ctx, DefaultHealthCheckTimeout)
+	defer cancel()
+
+	url := "https://" + net.JoinHostPort(host, strconv.Itoa(int(port))) + "/readyz"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return false
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	return res.StatusCode == http.StatusOK
+}
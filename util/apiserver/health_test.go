@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"sigs.k8s.io/cluster-api-provider-digitalocean/util/apiserver"
+)
+
+func TestCheckHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   bool
+	}{
+		{name: "ready", status: http.StatusOK, want: true},
+		{name: "not ready", status: http.StatusInternalServerError, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/readyz" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			host, port := splitHostPort(t, srv.Listener.Addr().String())
+			if got := apiserver.CheckHealth(context.TODO(), host, port); got != tt.want {
+				t.Errorf("CheckHealth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckHealth_Unreachable(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, port := splitHostPort(t, l.Addr().String())
+	l.Close() //nolint:errcheck
+
+	if apiserver.CheckHealth(context.TODO(), host, port) {
+		t.Errorf("CheckHealth() = true for a closed port")
+	}
+}
+
+func splitHostPort(t *testing.T, addr string) (string, int32) {
+	t.Helper()
+
+	host, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return host, int32(port)
+}
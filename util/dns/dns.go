@@ -19,16 +19,21 @@ package dns
 
 import (
 	"fmt"
+	"net"
+	"sort"
 	"strings"
 	"sync"
 
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
 	"github.com/miekg/dns"
 	"sigs.k8s.io/cluster-api-provider-digitalocean/util/dns/resolver"
 )
 
 var (
-	syncOnce        sync.Once
-	defaultResolver resolver.DNSResolver
+	syncOnce          sync.Once
+	defaultResolver   resolver.DNSResolver
+	propagationConfig PropagationConfig
 )
 
 func init() {
@@ -42,6 +47,42 @@ func InitFromDNSResolver(resolver resolver.DNSResolver) {
 	})
 }
 
+// PropagationConfig configures how DNS propagation is checked.
+type PropagationConfig struct {
+	// Nameservers are queried instead of the authoritative nameservers of the
+	// zone when set.
+	Nameservers []string
+	// Quorum is the number of nameservers which must answer with the record.
+	// All nameservers must answer if it is zero or greater than the number of
+	// nameservers.
+	Quorum int
+}
+
+// InitPropagationConfig sets the configuration used to check DNS propagation.
+func InitPropagationConfig(config PropagationConfig) {
+	propagationConfig = config
+}
+
+// PropagationResult is the result of a DNS propagation check.
+type PropagationResult struct {
+	// Nameservers are the nameservers which were queried.
+	Nameservers []string
+	// Propagated are the nameservers which answered with the record.
+	Propagated []string
+	// Quorum is the number of nameservers required to answer with the record.
+	Quorum int
+}
+
+// IsPropagated returns whether a quorum of nameservers answered with the record.
+func (r *PropagationResult) IsPropagated() bool {
+	return len(r.Propagated) >= r.Quorum
+}
+
+// String returns a human readable summary of the check.
+func (r *PropagationResult) String() string {
+	return fmt.Sprintf("%d/%d nameservers answered with the record (quorum %d)", len(r.Propagated), len(r.Nameservers), r.Quorum)
+}
+
 // ToFQDN ...
 func ToFQDN(name, domain string) string {
 	fqdn := fmt.Sprintf("%s.%s", name, domain)
@@ -54,60 +95,153 @@ func ToFQDN(name, domain string) string {
 
 // CheckDNSPropagated checks if the DNS is propagated.
 func CheckDNSPropagated(fqdn, ip string) (bool, error) {
-	m := new(dns.Msg)
-	m.SetQuestion(fqdn, dns.TypeA)
-
-	authNS, err := LookupAuthoritativeServer(fqdn)
+	result, err := CheckDNSPropagation(fqdn, ip)
 	if err != nil {
 		return false, err
 	}
+	return result.IsPropagated(), nil
+}
 
-	resp, err := defaultResolver.Query([]string{authNS}, m)
+// CheckDNSPropagation queries the configured nameservers, or all the
+// authoritative nameservers of the zone, or the endpoint of a recursive
+// resolver, for the A record of fqdn, or its AAAA record if ip is an IPv6
+// address, and reports which ones answer with ip.
+// Nameservers which cannot be reached count as not propagated; an error is
+// only returned if none of them answered.
+func CheckDNSPropagation(fqdn, ip string) (*PropagationResult, error) {
+	want := net.ParseIP(ip)
+	if want == nil {
+		return nil, fmt.Errorf("invalid IP address %q", ip)
+	}
+	qType := dns.TypeA
+	if want.To4() == nil {
+		qType = dns.TypeAAAA
+	}
+
+	nameservers, err := propagationNameservers(fqdn)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	result := &PropagationResult{
+		Nameservers: nameservers,
+		Quorum:      len(nameservers),
 	}
+	if q := propagationConfig.Quorum; q > 0 && q < len(nameservers) {
+		result.Quorum = q
+	}
+
+	m := new(dns.Msg)
+	m.SetQuestion(fqdn, qType)
+
+	var errs []error
+	for _, ns := range nameservers {
+		resp, err := defaultResolver.Query([]string{ns}, m)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ns, err))
+			continue
+		}
+		if hasAddressRecord(resp, want) {
+			result.Propagated = append(result.Propagated, ns)
+		}
+	}
+	if len(errs) == len(nameservers) {
+		return nil, kerrors.NewAggregate(errs)
+	}
+
+	return result, nil
+}
 
+// propagationNameservers returns the nameservers to check the propagation of
+// fqdn against. A recursive resolver answers every query itself, so asking it
+// once per nameserver would only repeat the same cached answer: it is queried
+// as the single nameserver instead.
+func propagationNameservers(fqdn string) ([]string, error) {
+	if r, ok := defaultResolver.(resolver.RecursiveResolver); ok {
+		return []string{r.Endpoint()}, nil
+	}
+	if len(propagationConfig.Nameservers) > 0 {
+		return propagationConfig.Nameservers, nil
+	}
+	return LookupAuthoritativeServers(fqdn)
+}
+
+func hasAddressRecord(resp *dns.Msg, ip net.IP) bool {
 	for _, ans := range resp.Answer {
-		switch a := ans.(type) {
+		switch rr := ans.(type) {
 		case *dns.A:
-			if a.A.String() == ip {
-				return true, nil
+			if rr.A.Equal(ip) {
+				return true
+			}
+		case *dns.AAAA:
+			if rr.AAAA.Equal(ip) {
+				return true
 			}
-		default:
-			continue
 		}
 	}
-
-	return false, nil
+	return false
 }
 
 // LookupAuthoritativeServer ...
 func LookupAuthoritativeServer(fqdn string) (string, error) {
+	soa, err := lookupSOA(fqdn)
+	if err != nil {
+		return "", err
+	}
+	return soa.Ns, nil
+}
+
+// LookupAuthoritativeServers returns all the nameservers of the zone fqdn
+// belongs to, falling back to the primary nameserver of its SOA record if the
+// zone NS records cannot be resolved.
+func LookupAuthoritativeServers(fqdn string) ([]string, error) {
+	soa, err := lookupSOA(fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	m := new(dns.Msg)
+	m.SetQuestion(soa.Hdr.Name, dns.TypeNS)
+	m.RecursionDesired = true
+	resp, err := defaultResolver.LocalQuery(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameservers []string
+	for _, rr := range resp.Answer {
+		if ns, ok := rr.(*dns.NS); ok {
+			nameservers = append(nameservers, ns.Ns)
+		}
+	}
+	if len(nameservers) == 0 {
+		return []string{soa.Ns}, nil
+	}
+	sort.Strings(nameservers)
+
+	return nameservers, nil
+}
+
+func lookupSOA(fqdn string) (*dns.SOA, error) {
 	m := new(dns.Msg)
 	m.SetQuestion(fqdn, dns.TypeSOA)
 	m.RecursionDesired = true
 	resp, err := defaultResolver.LocalQuery(m)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if len(resp.Ns) < 1 {
-		return "", fmt.Errorf("didn't get DNS authority section")
+		return nil, fmt.Errorf("didn't get DNS authority section")
 	}
 
-	var authNS string
 	for _, rr := range resp.Ns {
 		soa, ok := rr.(*dns.SOA)
-		if !ok {
+		if !ok || soa.Ns == "" {
 			continue
 		}
-		authNS = soa.Ns
-		break
-	}
-
-	if authNS == "" {
-		return "", fmt.Errorf("didn't find authority NS")
+		return soa, nil
 	}
 
-	return authNS, nil
+	return nil, fmt.Errorf("didn't find authority NS")
 }
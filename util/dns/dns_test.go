@@ -19,6 +19,7 @@ package dns
 import (
 	"fmt"
 	"net"
+	"reflect"
 	"testing"
 
 	"github.com/miekg/dns"
@@ -43,6 +44,26 @@ func newDNSTypeAMsg(name string, ip net.IP) *dns.Msg {
 	}
 }
 
+func newDNSTypeAAAAMsg(name string, ip net.IP) *dns.Msg {
+	return &dns.Msg{
+		Answer: []dns.RR{&dns.AAAA{
+			Hdr:  dns.RR_Header{Name: name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET},
+			AAAA: ip,
+		}},
+	}
+}
+
+func newDNSTypeNSMsg(zone string, nameservers ...string) *dns.Msg {
+	msg := &dns.Msg{}
+	for _, ns := range nameservers {
+		msg.Answer = append(msg.Answer, &dns.NS{
+			Hdr: dns.RR_Header{Name: zone, Rrtype: dns.TypeNS, Class: dns.ClassINET},
+			Ns:  ns,
+		})
+	}
+	return msg
+}
+
 func TestCheckDNSPropagated(t *testing.T) {
 	host := "foo"
 	domain := "test.go"
@@ -69,6 +90,7 @@ func TestCheckDNSPropagated(t *testing.T) {
 			},
 			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
 				newDNSTypeSOAMsg(host, fakeAuthoritativeNSName),
+				{},
 				newDNSTypeAMsg(fqdn, hostIP),
 			}),
 			wantPropagated: true,
@@ -82,6 +104,7 @@ func TestCheckDNSPropagated(t *testing.T) {
 			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
 				newDNSTypeSOAMsg(host, fakeAuthoritativeNSName),
 				{},
+				{},
 			}),
 			wantPropagated: false,
 		},
@@ -93,6 +116,7 @@ func TestCheckDNSPropagated(t *testing.T) {
 			},
 			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
 				newDNSTypeSOAMsg(host, fakeAuthoritativeNSName),
+				{},
 				newDNSTypeAMsg(fqdn, net.IPv4(192, 168, 1, 1)),
 			}),
 			wantPropagated: false,
@@ -124,3 +148,115 @@ func TestCheckDNSPropagated(t *testing.T) {
 		})
 	}
 }
+
+// fakeRecursiveResolver answers all queries like a DNS over HTTPS endpoint.
+type fakeRecursiveResolver struct {
+	*resolver.FakeDNSResolver
+	endpoint string
+}
+
+func (r *fakeRecursiveResolver) Endpoint() string {
+	return r.endpoint
+}
+
+func TestCheckDNSPropagation(t *testing.T) {
+	fqdn := ToFQDN("foo", "test.go")
+	hostIP := net.IPv4(9, 9, 9, 9)
+	hostIPv6 := net.ParseIP("2001:db8::9")
+
+	tests := []struct {
+		name           string
+		ip             string
+		config         PropagationConfig
+		fakeResolver   resolver.DNSResolver
+		wantQueried    []string
+		wantPropagated []string
+		wantResult     bool
+	}{
+		{
+			name: "all authoritative nameservers are required by default",
+			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
+				newDNSTypeSOAMsg("test.go.", "ns1.test.go."),
+				newDNSTypeNSMsg("test.go.", "ns2.test.go.", "ns1.test.go."),
+				newDNSTypeAMsg(fqdn, hostIP),
+				{},
+			}),
+			wantQueried:    []string{"ns1.test.go.", "ns2.test.go."},
+			wantPropagated: []string{"ns1.test.go."},
+			wantResult:     false,
+		},
+		{
+			name:   "quorum of authoritative nameservers",
+			config: PropagationConfig{Quorum: 2},
+			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
+				newDNSTypeSOAMsg("test.go.", "ns1.test.go."),
+				newDNSTypeNSMsg("test.go.", "ns1.test.go.", "ns2.test.go.", "ns3.test.go."),
+				newDNSTypeAMsg(fqdn, hostIP),
+				{},
+				newDNSTypeAMsg(fqdn, hostIP),
+			}),
+			wantQueried:    []string{"ns1.test.go.", "ns2.test.go.", "ns3.test.go."},
+			wantPropagated: []string{"ns1.test.go.", "ns3.test.go."},
+			wantResult:     true,
+		},
+		{
+			name:   "configured nameservers",
+			config: PropagationConfig{Nameservers: []string{"192.0.2.53"}},
+			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
+				newDNSTypeAMsg(fqdn, hostIP),
+			}),
+			wantQueried:    []string{"192.0.2.53"},
+			wantPropagated: []string{"192.0.2.53"},
+			wantResult:     true,
+		},
+		{
+			name:   "AAAA record",
+			ip:     "2001:db8::9",
+			config: PropagationConfig{Nameservers: []string{"192.0.2.53", "192.0.2.54"}},
+			fakeResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
+				newDNSTypeAAAAMsg(fqdn, hostIPv6),
+				newDNSTypeAMsg(fqdn, hostIP),
+			}),
+			wantQueried:    []string{"192.0.2.53", "192.0.2.54"},
+			wantPropagated: []string{"192.0.2.53"},
+			wantResult:     false,
+		},
+		{
+			name: "recursive resolver is queried once",
+			fakeResolver: &fakeRecursiveResolver{
+				FakeDNSResolver: resolver.NewFakeDNSResolver([]*dns.Msg{
+					newDNSTypeAMsg(fqdn, hostIP),
+				}),
+				endpoint: "https://dns.example.com/dns-query",
+			},
+			wantQueried:    []string{"https://dns.example.com/dns-query"},
+			wantPropagated: []string{"https://dns.example.com/dns-query"},
+			wantResult:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaultResolver = tt.fakeResolver
+			propagationConfig = tt.config
+			defer func() { propagationConfig = PropagationConfig{} }()
+
+			ip := tt.ip
+			if ip == "" {
+				ip = "9.9.9.9"
+			}
+			result, err := CheckDNSPropagation(fqdn, ip)
+			if err != nil {
+				t.Fatalf("CheckDNSPropagation() error = %v", err)
+			}
+			if !reflect.DeepEqual(result.Nameservers, tt.wantQueried) {
+				t.Errorf("CheckDNSPropagation() nameservers = %v, want %v", result.Nameservers, tt.wantQueried)
+			}
+			if !reflect.DeepEqual(result.Propagated, tt.wantPropagated) {
+				t.Errorf("CheckDNSPropagation() propagated = %v, want %v", result.Propagated, tt.wantPropagated)
+			}
+			if result.IsPropagated() != tt.wantResult {
+				t.Errorf("CheckDNSPropagation() IsPropagated = %v, want %v", result.IsPropagated(), tt.wantResult)
+			}
+		})
+	}
+}
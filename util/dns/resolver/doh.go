@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+const (
+	dohMediaType       = "application/dns-message"
+	dohDefaultTimeout  = 10 * time.Second
+	dohMaxResponseSize = 65535
+)
+
+type dohResolver struct {
+	endpoint string
+	client   *http.Client
+	timeout  time.Duration
+}
+
+// NewDoHResolver creates a resolver sending all queries to the given DNS over
+// HTTPS (RFC 8484) endpoint. As the endpoint is a recursive resolver, queries
+// meant for specific nameservers are answered by the endpoint as well, see
+// RecursiveResolver.
+func NewDoHResolver(endpoint string) (DNSResolver, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid DNS over HTTPS endpoint")
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, errors.Errorf("invalid DNS over HTTPS endpoint %q: must be an https URL", endpoint)
+	}
+
+	return &dohResolver{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: dohDefaultTimeout},
+		timeout:  dohDefaultTimeout,
+	}, nil
+}
+
+func (r *dohResolver) Query(_ []string, msg *dns.Msg) (*dns.Msg, error) {
+	// The message ID is zeroed to make responses cacheable, as recommended by
+	// RFC 8484, and recursion is always requested from the endpoint.
+	q := msg.Copy()
+	q.Id = 0
+	q.RecursionDesired = true
+	packed, err := q.Pack()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack DNS query")
+	}
+
+	// A stalled endpoint must not block the reconcile worker checking the
+	// DNS propagation.
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "DNS over HTTPS query failed")
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("DNS over HTTPS endpoint answered %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dohMaxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read DNS over HTTPS response")
+	}
+
+	answer := new(dns.Msg)
+	if err := answer.Unpack(body); err != nil {
+		return nil, errors.Wrap(err, "failed to unpack DNS over HTTPS response")
+	}
+	answer.Id = msg.Id
+
+	return answer, nil
+}
+
+func (r *dohResolver) Endpoint() string {
+	return r.endpoint
+}
+
+func (r *dohResolver) LocalQuery(msg *dns.Msg) (*dns.Msg, error) {
+	return r.Query(nil, msg)
+}
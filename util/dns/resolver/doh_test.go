@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func TestDoHResolver(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != dohMediaType {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		q := new(dns.Msg)
+		if err := q.Unpack(body); err != nil || q.Id != 0 || !q.RecursionDesired {
+			http.Error(w, "bad query", http.StatusBadRequest)
+			return
+		}
+
+		m := new(dns.Msg)
+		m.SetReply(q)
+		m.Answer = append(m.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: q.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET},
+			A:   net.IPv4(192, 0, 2, 1),
+		})
+		packed, _ := m.Pack()
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(packed)
+	}))
+	defer srv.Close()
+
+	if _, err := NewDoHResolver("http://dns.example.com/dns-query"); err == nil {
+		t.Errorf("NewDoHResolver() expected an error for a non https endpoint")
+	}
+
+	r, err := NewDoHResolver(srv.URL + "/dns-query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.(*dohResolver).client = srv.Client()
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("api.example.com.", dns.TypeA)
+	for name, query := range map[string]func(*dns.Msg) (*dns.Msg, error){
+		"Query":      func(m *dns.Msg) (*dns.Msg, error) { return r.Query([]string{"ns1.example.com."}, m) },
+		"LocalQuery": r.LocalQuery,
+	} {
+		resp, err := query(msg)
+		if err != nil {
+			t.Fatalf("%s() error = %v", name, err)
+		}
+		if resp.Id != msg.Id {
+			t.Errorf("%s() id = %d, want %d", name, resp.Id, msg.Id)
+		}
+		if len(resp.Answer) != 1 || resp.Answer[0].(*dns.A).A.String() != "192.0.2.1" {
+			t.Errorf("%s() answer = %v", name, resp.Answer)
+		}
+	}
+}
+
+func TestDoHResolverTimeout(t *testing.T) {
+	stop := make(chan struct{})
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-stop:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(stop)
+
+	r, err := NewDoHResolver(srv.URL + "/dns-query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.(*dohResolver).client = srv.Client()
+	r.(*dohResolver).timeout = 50 * time.Millisecond
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("api.example.com.", dns.TypeA)
+	if _, err := r.Query(nil, msg); err == nil {
+		t.Errorf("Query() expected an error for a stalled endpoint")
+	}
+	if got := r.(RecursiveResolver).Endpoint(); got != srv.URL+"/dns-query" {
+		t.Errorf("Endpoint() = %q, want %q", got, srv.URL+"/dns-query")
+	}
+}
@@ -28,6 +28,14 @@ type DNSResolver interface {
 	LocalQuery(msg *dns.Msg) (*dns.Msg, error)
 }
 
+// RecursiveResolver is implemented by resolvers which cannot query specific
+// nameservers: every query is answered by the recursive resolver at Endpoint,
+// whatever the servers it is meant for.
+type RecursiveResolver interface {
+	DNSResolver
+	Endpoint() string
+}
+
 type resolver struct {
 	config *dns.ClientConfig
 	client *dns.Client